@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/t3201v/seat-arrangement/gen/cinema"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// parseTime reads an RFC 3339 time, or a duration before now like 24h
+func parseTime(s string) (*timestamppb.Timestamp, error) {
+	if s == "" {
+		return nil, nil
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return timestamppb.New(time.Now().Add(-d)), nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, fmt.Errorf("malformed time %q, want RFC 3339 or a duration", s)
+	}
+	return timestamppb.New(t), nil
+}
+
+func runAudit(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("audit")
+	id := fs.String("id", "", "id of the cinema")
+	actor := fs.String("actor", "", "name of the actor")
+	since := fs.String("since", "", "oldest events, RFC 3339 time or duration before now, e.g. 24h")
+	until := fs.String("until", "", "newest events (excluded), RFC 3339 time or duration before now")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	request := &cinema.ListAuditEventsRequest{CinemaId: *id, Actor: *actor}
+	var err error
+	if request.Since, err = parseTime(*since); err != nil {
+		return err
+	}
+	if request.Until, err = parseTime(*until); err != nil {
+		return err
+	}
+
+	ctx, cancel := c.call(ctx)
+	defer cancel()
+	res, err := c.client.ListAuditEvents(ctx, request)
+	if err != nil {
+		return err
+	}
+	return c.print(res, func() {
+		for _, event := range res.Events {
+			seats := make([]string, 0, len(event.Seats))
+			for _, seat := range event.Seats {
+				seats = append(seats, seatLabel(seat))
+			}
+			result := "ok"
+			if !event.Success {
+				result = "failed: " + event.Error
+			}
+			fmt.Fprintf(c.out, "%s %s %s %s cinema=%s group=%s seats=%s %s\n",
+				event.Time.AsTime().Local().Format(time.RFC3339), event.RequestId, event.Actor, event.Operation,
+				event.CinemaId, event.GroupName, strings.Join(seats, ","), result)
+		}
+	})
+}
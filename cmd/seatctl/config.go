@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/t3201v/seat-arrangement/gen/cinema"
+)
+
+// configFlags are the settings shared by configure and update
+type configFlags struct {
+	rows, columns, minDistance int
+	unsellable                 string
+	categories, prices         listFlag
+	currency                   string
+}
+
+func (f *configFlags) register(fs *flag.FlagSet) {
+	fs.IntVar(&f.rows, "rows", 0, "number of rows")
+	fs.IntVar(&f.columns, "columns", 0, "number of seats in a row")
+	fs.IntVar(&f.minDistance, "min-distance", 0, "minimum Manhattan distance between groups")
+	fs.StringVar(&f.unsellable, "unsellable", "", "comma-separated seats that can never be reserved")
+	fs.Var(&f.categories, "category", "seats of a category, e.g. vip=A1,A2, may be repeated")
+	fs.Var(&f.prices, "price", "price of a category in minor units, e.g. vip=1500, may be repeated")
+	fs.StringVar(&f.currency, "currency", "", "currency of the prices")
+}
+
+func (f *configFlags) parse() (unsellable []*cinema.Seat, categories []*cinema.CategoryAssignment, prices []*cinema.CategoryPrice, err error) {
+	if f.unsellable != "" {
+		unsellable, err = parseSeats([]string{f.unsellable})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	for _, value := range f.categories {
+		name, seatList, ok := strings.Cut(value, "=")
+		if !ok {
+			return nil, nil, nil, fmt.Errorf("malformed category %q, want CATEGORY=SEATS", value)
+		}
+		category, err := parseCategory(name)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		seats, err := parseSeats([]string{seatList})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		categories = append(categories, &cinema.CategoryAssignment{Category: category, Seats: seats})
+	}
+	for _, value := range f.prices {
+		name, amount, ok := strings.Cut(value, "=")
+		if !ok {
+			return nil, nil, nil, fmt.Errorf("malformed price %q, want CATEGORY=AMOUNT", value)
+		}
+		category, err := parseCategory(name)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		price, err := strconv.ParseInt(amount, 10, 64)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("malformed price %q", value)
+		}
+		prices = append(prices, &cinema.CategoryPrice{Category: category, Price: price})
+	}
+	return unsellable, categories, prices, nil
+}
+
+func runConfigure(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("configure")
+	var f configFlags
+	f.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if f.rows <= 0 || f.columns <= 0 {
+		return errors.New("-rows and -columns must be positive")
+	}
+	unsellable, categories, prices, err := f.parse()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := c.call(ctx)
+	defer cancel()
+	res, err := c.client.ConfigureCinema(ctx, &cinema.ConfigureCinemaRequest{
+		Rows:            int32(f.rows),
+		Columns:         int32(f.columns),
+		MinDistance:     int32(f.minDistance),
+		Categories:      categories,
+		Prices:          prices,
+		Currency:        f.currency,
+		UnsellableSeats: unsellable,
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(res, func() { fmt.Fprintln(c.out, res.Id) })
+}
+
+func runUpdate(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("update")
+	id := fs.String("id", "", "id of the cinema")
+	var f configFlags
+	f.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *id == "" {
+		return errors.New("missing -id")
+	}
+	unsellable, categories, prices, err := f.parse()
+	if err != nil {
+		return err
+	}
+
+	// the update replaces the dimensions and the distance, keep the current ones unless given
+	set := make(map[string]bool)
+	fs.Visit(func(fl *flag.Flag) { set[fl.Name] = true })
+	if !set["rows"] || !set["columns"] || !set["min-distance"] {
+		ctx, cancel := c.call(ctx)
+		current, err := c.client.GetSeatMap(ctx, &cinema.GetSeatMapRequest{Id: *id})
+		cancel()
+		if err != nil {
+			return err
+		}
+		if !set["rows"] {
+			f.rows = int(current.Rows)
+		}
+		if !set["columns"] {
+			f.columns = int(current.Columns)
+		}
+		if !set["min-distance"] {
+			f.minDistance = int(current.MinDistance)
+		}
+	}
+
+	ctx, cancel := c.call(ctx)
+	defer cancel()
+	res, err := c.client.UpdateCinemaConfig(ctx, &cinema.UpdateCinemaConfigRequest{
+		Id:              *id,
+		Rows:            int32(f.rows),
+		Columns:         int32(f.columns),
+		MinDistance:     int32(f.minDistance),
+		Categories:      categories,
+		Prices:          prices,
+		Currency:        f.currency,
+		UnsellableSeats: unsellable,
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(res, func() { fmt.Fprintln(c.out, "updated", *id) })
+}
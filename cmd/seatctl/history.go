@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/t3201v/seat-arrangement/gen/cinema"
+)
+
+// parsePoint reads a version number, an RFC 3339 time or a duration before now
+func parsePoint(s string) (*cinema.PointInTime, error) {
+	if s == "" {
+		return nil, nil
+	}
+	if n, err := strconv.Atoi(s); err == nil {
+		return &cinema.PointInTime{Point: &cinema.PointInTime_Version{Version: int32(n)}}, nil
+	}
+	t, err := parseTime(s)
+	if err != nil {
+		return nil, fmt.Errorf("malformed point in time %q, want a version, RFC 3339 time or duration", s)
+	}
+	return &cinema.PointInTime{Point: &cinema.PointInTime_Time{Time: t}}, nil
+}
+
+func describeState(status cinema.SeatStatus, group string) string {
+	if status != cinema.SeatStatus_SEAT_STATUS_RESERVED {
+		return "free"
+	}
+	if group == "" {
+		return "reserved"
+	}
+	return group
+}
+
+func printChanges(c *cli, changes []*cinema.SeatChange) {
+	for _, change := range changes {
+		fmt.Fprintf(c.out, "%s: %s -> %s\n", seatLabel(change.Seat),
+			describeState(change.FromStatus, change.FromGroup), describeState(change.ToStatus, change.ToGroup))
+	}
+}
+
+func runVersions(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("versions")
+	id := fs.String("id", "", "id of the cinema")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *id == "" {
+		return errors.New("missing -id")
+	}
+
+	ctx, cancel := c.call(ctx)
+	defer cancel()
+	res, err := c.client.ListCinemaVersions(ctx, &cinema.ListCinemaVersionsRequest{Id: *id})
+	if err != nil {
+		return err
+	}
+	return c.print(res, func() {
+		for _, version := range res.Versions {
+			fmt.Fprintf(c.out, "%d %s %s\n", version.Version, version.Time.AsTime().Local().Format(time.RFC3339), version.Event)
+		}
+	})
+}
+
+func runDiff(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("diff")
+	id := fs.String("id", "", "id of the cinema")
+	from := fs.String("from", "", "version, time or duration before now to compare from")
+	to := fs.String("to", "", "version, time or duration before now to compare to, the current version by default")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *id == "" || *from == "" {
+		return errors.New("missing -id or -from")
+	}
+	request := &cinema.DiffCinemaRequest{Id: *id}
+	var err error
+	if request.From, err = parsePoint(*from); err != nil {
+		return err
+	}
+	if request.To, err = parsePoint(*to); err != nil {
+		return err
+	}
+
+	ctx, cancel := c.call(ctx)
+	defer cancel()
+	res, err := c.client.DiffCinema(ctx, request)
+	if err != nil {
+		return err
+	}
+	return c.print(res, func() {
+		fmt.Fprintf(c.out, "version %d -> %d\n", res.FromVersion, res.ToVersion)
+		printChanges(c, res.Changes)
+	})
+}
+
+func runRevert(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("revert")
+	id := fs.String("id", "", "id of the cinema")
+	to := fs.String("to", "", "version, time or duration before now to revert to")
+	discard := fs.Bool("discard-newer", false, "also cancel the reservations made since")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *id == "" || *to == "" {
+		return errors.New("missing -id or -to")
+	}
+	point, err := parsePoint(*to)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := c.call(ctx)
+	defer cancel()
+	res, err := c.client.RevertCinema(ctx, &cinema.RevertCinemaRequest{Id: *id, To: point, DiscardNewer: *discard})
+	if err != nil {
+		return err
+	}
+	return c.print(res, func() {
+		fmt.Fprintf(c.out, "reverted to version %d\n", res.Version)
+		printChanges(c, res.Changes)
+	})
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/t3201v/seat-arrangement/gen/cinema"
+)
+
+var layoutFormats = map[string]cinema.LayoutFormat{
+	"json": cinema.LayoutFormat_LAYOUT_FORMAT_JSON,
+	"yaml": cinema.LayoutFormat_LAYOUT_FORMAT_YAML,
+	"yml":  cinema.LayoutFormat_LAYOUT_FORMAT_YAML,
+	"csv":  cinema.LayoutFormat_LAYOUT_FORMAT_CSV,
+}
+
+// parseLayoutFormat returns the named format, or guesses it from the file extension
+func parseLayoutFormat(name, file string) (cinema.LayoutFormat, error) {
+	if name == "" {
+		name = strings.TrimPrefix(filepath.Ext(file), ".")
+		if name == "" {
+			name = "json"
+		}
+	}
+	format, ok := layoutFormats[strings.ToLower(name)]
+	if !ok {
+		return 0, fmt.Errorf("unknown layout format %q", name)
+	}
+	return format, nil
+}
+
+func runExport(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("export")
+	id := fs.String("id", "", "id of the cinema")
+	formatName := fs.String("format", "", "json, yaml or csv, guessed from the output file by default")
+	reservations := fs.Bool("reservations", false, "include the current reservations")
+	output := fs.String("o", "", "output file, stdout by default")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *id == "" {
+		return errors.New("missing -id")
+	}
+	format, err := parseLayoutFormat(*formatName, *output)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := c.call(ctx)
+	defer cancel()
+	res, err := c.client.ExportCinema(ctx, &cinema.ExportCinemaRequest{
+		Id:                  *id,
+		Format:              format,
+		IncludeReservations: *reservations,
+	})
+	if err != nil {
+		return err
+	}
+	if *output != "" {
+		return os.WriteFile(*output, []byte(res.Data), 0o644)
+	}
+	return c.print(res, func() { fmt.Fprint(c.out, res.Data) })
+}
+
+func runImport(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("import")
+	formatName := fs.String("format", "", "json, yaml or csv, guessed from the file extension by default")
+	minDistance := fs.Int("min-distance", 0, "minimum distance, only for csv files")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return errors.New("expected one file")
+	}
+	format, err := parseLayoutFormat(*formatName, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := c.call(ctx)
+	defer cancel()
+	res, err := c.client.ImportCinema(ctx, &cinema.ImportCinemaRequest{
+		Format:      format,
+		Data:        string(data),
+		MinDistance: int32(*minDistance),
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(res, func() { fmt.Fprintln(c.out, res.Id) })
+}
@@ -0,0 +1,146 @@
+// Command seatctl operates the seat arrangement service over gRPC
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"time"
+
+	"github.com/t3201v/seat-arrangement/gen/cinema"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+type command struct {
+	usage string
+	run   func(ctx context.Context, cli *cli, args []string) error
+}
+
+var commands map[string]command
+
+func init() {
+	commands = map[string]command{
+		"configure": {"configure -rows N -columns N [-min-distance N] [-unsellable SEATS] [-category CATEGORY=SEATS]... [-price CATEGORY=AMOUNT]... [-currency CODE]", runConfigure},
+		"update":    {"update -id ID [-rows N] [-columns N] [-min-distance N] [-unsellable SEATS] [-category CATEGORY=SEATS]... [-price CATEGORY=AMOUNT]... [-currency CODE]", runUpdate},
+		"show":      {"show -id ID [-group NAME] [-at VERSION|TIME]", runShow},
+		"pick":      {"pick -id ID -group NAME [-accessible] [-interval DURATION]", runPick},
+		"reserve":   {"reserve -id ID -group NAME [-accessible] SEAT...", runReserve},
+		"cancel":    {"cancel -id ID SEAT...", runCancel},
+		"export":    {"export -id ID [-format json|yaml|csv] [-reservations] [-o FILE]", runExport},
+		"import":    {"import [-format json|yaml|csv] [-min-distance N] FILE", runImport},
+		"watch":     {"watch -id ID [-group NAME] [-interval DURATION]", runWatch},
+		"versions":  {"versions -id ID", runVersions},
+		"diff":      {"diff -id ID -from VERSION|TIME [-to VERSION|TIME]", runDiff},
+		"revert":    {"revert -id ID -to VERSION|TIME [-discard-newer]", runRevert},
+		"audit":     {"audit [-id ID] [-actor NAME] [-since TIME] [-until TIME]", runAudit},
+	}
+}
+
+// cli holds the connection and the global flags shared by the commands
+type cli struct {
+	client  cinema.CinemaServiceClient
+	timeout time.Duration
+	json    bool
+	color   bool
+	out     io.Writer
+}
+
+// call returns the context of a single request
+func (c *cli) call(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, c.timeout)
+}
+
+// print writes the response as JSON with -json, or calls text otherwise
+func (c *cli) print(res proto.Message, text func()) error {
+	if !c.json {
+		text()
+		return nil
+	}
+	data, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(c.out, string(data))
+	return err
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: seatctl [-server HOST:PORT] [-json] COMMAND [ARGS]")
+	fmt.Fprintln(os.Stderr, "seats are given by label, e.g. F12, or by coordinates, e.g. 5:11")
+	fmt.Fprintln(os.Stderr, "commands:")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintln(os.Stderr, "  "+commands[name].usage)
+	}
+	flag.PrintDefaults()
+}
+
+func main() {
+	server := flag.String("server", "localhost:9045", "gRPC server address")
+	timeout := flag.Duration("timeout", 10*time.Second, "timeout of every request")
+	jsonOutput := flag.Bool("json", false, "print responses as JSON")
+	noColor := flag.Bool("no-color", os.Getenv("NO_COLOR") != "", "do not color the seat grid")
+	role := flag.String("role", "", "role asked of the server, keys with the staff role see group names with -role staff")
+	key := flag.String("key", os.Getenv("SEATCTL_API_KEY"), "api key, the server binds it to an organization")
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+	cmd, ok := commands[flag.Arg(0)]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "seatctl: unknown command %q\n", flag.Arg(0))
+		usage()
+		os.Exit(2)
+	}
+
+	conn, err := grpc.NewClient(*server, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "seatctl:", err)
+		os.Exit(1)
+	}
+	defer conn.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if *role != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-role", *role)
+	}
+	if *key != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+*key)
+	}
+	c := &cli{
+		client:  cinema.NewCinemaServiceClient(conn),
+		timeout: *timeout,
+		json:    *jsonOutput,
+		color:   !*noColor,
+		out:     os.Stdout,
+	}
+	if err := cmd.run(ctx, c, flag.Args()[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "seatctl:", err)
+		os.Exit(1)
+	}
+}
+
+// newFlagSet returns the flags of a command, printing its usage on errors
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: seatctl "+commands[name].usage)
+		fs.PrintDefaults()
+	}
+	return fs
+}
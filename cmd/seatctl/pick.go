@@ -0,0 +1,390 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/t3201v/seat-arrangement/gen/cinema"
+)
+
+type key int
+
+const (
+	keyUp key = iota
+	keyDown
+	keyLeft
+	keyRight
+	keySelect
+	keyReserve
+	keyCancel
+	keyClear
+	keyRefresh
+	keyQuit
+)
+
+var keyBindings = map[byte]key{
+	'k': keyUp, 'j': keyDown, 'h': keyLeft, 'l': keyRight,
+	' ': keySelect, '\r': keySelect,
+	'r': keyReserve, 'c': keyCancel, 'u': keyClear, 'g': keyRefresh,
+	'q': keyQuit, 3: keyQuit, // Ctrl-C
+}
+
+// parseKeys decodes the bytes read from a raw terminal, arrows are sent as ESC [ A..D
+func parseKeys(buf []byte) []key {
+	keys := make([]key, 0, len(buf))
+	for i := 0; i < len(buf); i++ {
+		if buf[i] == 0x1b && i+2 < len(buf) && buf[i+1] == '[' {
+			switch buf[i+2] {
+			case 'A':
+				keys = append(keys, keyUp)
+			case 'B':
+				keys = append(keys, keyDown)
+			case 'C':
+				keys = append(keys, keyRight)
+			case 'D':
+				keys = append(keys, keyLeft)
+			}
+			i += 2
+			continue
+		}
+		if k, ok := keyBindings[buf[i]]; ok {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// picker is the state of the interactive seat picker, only the event loop changes it
+type picker struct {
+	cli        *cli
+	id         string
+	group      string
+	accessible bool
+
+	seatMap  *cinema.GetSeatMapResponse
+	row, col int
+	selected map[[2]int]bool
+	message  string
+}
+
+func runPick(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("pick")
+	id := fs.String("id", "", "id of the cinema")
+	group := fs.String("group", "", "name of the group reserving or cancelling")
+	accessible := fs.Bool("accessible", false, "the group needs wheelchair spaces")
+	interval := fs.Duration("interval", 2*time.Second, "time between two refreshes")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *id == "" || *group == "" {
+		return errors.New("missing -id or -group")
+	}
+	if *interval <= 0 {
+		return errors.New("-interval must be positive")
+	}
+
+	p := &picker{cli: c, id: *id, group: *group, accessible: *accessible, selected: make(map[[2]int]bool)}
+	if err := p.refresh(ctx); err != nil {
+		return err
+	}
+
+	restore, err := makeRaw(int(os.Stdin.Fd()))
+	if err != nil {
+		return fmt.Errorf("stdin is not a terminal: %w", err)
+	}
+	fmt.Fprint(c.out, "\033[?25l")
+	defer func() {
+		restore()
+		fmt.Fprint(c.out, "\033[?25h\n")
+	}()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	keys := make(chan []key)
+	go func() {
+		buf := make([]byte, 64)
+		for {
+			n, err := os.Stdin.Read(buf)
+			if err != nil {
+				cancel()
+				return
+			}
+			select {
+			case keys <- parseKeys(buf[:n]):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+
+	for {
+		p.draw()
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := p.refresh(ctx); err != nil {
+				p.message = err.Error()
+			}
+		case pressed := <-keys:
+			for _, k := range pressed {
+				if k == keyQuit {
+					return nil
+				}
+				p.handle(ctx, k)
+			}
+		}
+	}
+}
+
+func (p *picker) refresh(ctx context.Context) error {
+	ctx, cancel := p.cli.call(ctx)
+	defer cancel()
+	seatMap, err := p.cli.client.GetSeatMap(ctx, &cinema.GetSeatMapRequest{Id: p.id, GroupName: p.group})
+	if err != nil {
+		return err
+	}
+	p.seatMap = seatMap
+	p.row = max(min(p.row, int(seatMap.Rows)-1), 0)
+	p.col = max(min(p.col, int(seatMap.Columns)-1), 0)
+	for seat := range p.selected {
+		if seat[0] >= int(seatMap.Rows) || seat[1] >= int(seatMap.Columns) {
+			delete(p.selected, seat)
+		}
+	}
+	return nil
+}
+
+func (p *picker) handle(ctx context.Context, k key) {
+	if p.empty() && k != keyRefresh {
+		return
+	}
+	switch k {
+	case keyUp:
+		p.row = max(p.row-1, 0)
+	case keyDown:
+		p.row = min(p.row+1, int(p.seatMap.Rows)-1)
+	case keyLeft:
+		p.col = max(p.col-1, 0)
+	case keyRight:
+		p.col = min(p.col+1, int(p.seatMap.Columns)-1)
+	case keySelect:
+		seat := [2]int{p.row, p.col}
+		if p.selected[seat] {
+			delete(p.selected, seat)
+		} else {
+			p.selected[seat] = true
+		}
+	case keyClear:
+		p.selected = make(map[[2]int]bool)
+	case keyRefresh:
+		p.message = ""
+		if err := p.refresh(ctx); err != nil {
+			p.message = err.Error()
+		}
+	case keyReserve, keyCancel:
+		p.message = p.submit(ctx, k)
+		_ = p.refresh(ctx)
+	}
+}
+
+// submit reserves or cancels the selected seats and returns the message to show
+func (p *picker) submit(ctx context.Context, k key) string {
+	if len(p.selected) == 0 {
+		return "no seat selected"
+	}
+	seats := p.selection()
+	ctx, cancel := p.cli.call(ctx)
+	defer cancel()
+	if k == keyCancel {
+		if _, err := p.cli.client.CancelSeats(ctx, &cinema.CancelSeatsRequest{Id: p.id, SeatCoords: seats}); err != nil {
+			return "cancel failed: " + err.Error()
+		}
+		p.selected = make(map[[2]int]bool)
+		return fmt.Sprintf("cancelled %d seats", len(seats))
+	}
+	res, err := p.cli.client.ReserveSeats(ctx, &cinema.ReserveSeatsRequest{
+		Id:         p.id,
+		SeatCoords: seats,
+		GroupName:  p.group,
+		Accessible: p.accessible,
+	})
+	if err != nil {
+		return "reservation failed: " + err.Error()
+	}
+	p.selected = make(map[[2]int]bool)
+	return fmt.Sprintf("reserved %d seats for %s, total %d %s", len(seats), p.group, res.Total, res.Currency)
+}
+
+func (p *picker) selection() []*cinema.Seat {
+	seats := make([]*cinema.Seat, 0, len(p.selected))
+	for seat := range p.selected {
+		seats = append(seats, &cinema.Seat{Row: int32(seat[0]), Column: int32(seat[1])})
+	}
+	sort.Slice(seats, func(a, b int) bool {
+		if seats[a].Row != seats[b].Row {
+			return seats[a].Row < seats[b].Row
+		}
+		return seats[a].Column < seats[b].Column
+	})
+	return seats
+}
+
+// empty reports whether the seat map has no seat to put the cursor on
+func (p *picker) empty() bool {
+	return p.seatMap.Rows <= 0 || p.seatMap.Columns <= 0 || len(p.seatMap.SeatRows) < int(p.seatMap.Rows) ||
+		slices.ContainsFunc(p.seatMap.SeatRows, func(row *cinema.SeatRow) bool { return len(row.Seats) < int(p.seatMap.Columns) })
+}
+
+func (p *picker) state(row, col int) *cinema.SeatState {
+	return p.seatMap.SeatRows[row].Seats[col]
+}
+
+// near reports whether two seats are too close for different groups, from the exclusion zone
+// of the seat map or from the minimum distance when the server does not send it
+func (p *picker) near(row, col, otherRow, otherCol int) bool {
+	d, e := otherRow-row, otherCol-col
+	zone := p.seatMap.ExclusionZone
+	if len(zone) == 0 {
+		return abs(d)+abs(e) <= int(p.seatMap.MinDistance)
+	}
+	h, w := len(zone)/2, len(zone[0])/2
+	if abs(d) > h || abs(e) > w {
+		return false
+	}
+	return zone[h+d][w+e] != '.'
+}
+
+// reach returns how many rows and seats away from a seat near may be true
+func (p *picker) reach() (rows, cols int) {
+	zone := p.seatMap.ExclusionZone
+	if len(zone) == 0 {
+		return int(p.seatMap.MinDistance), int(p.seatMap.MinDistance)
+	}
+	return len(zone) / 2, len(zone[0]) / 2
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// zones previews the exclusion zones: around the selection, which other groups would lose,
+// and around the group under the cursor, which the selection must stay out of
+func (p *picker) zones() (selection, other map[[2]int]bool) {
+	rows, columns := int(p.seatMap.Rows), int(p.seatMap.Columns)
+	selection = make(map[[2]int]bool)
+	other = make(map[[2]int]bool)
+	cursor := p.state(p.row, p.col)
+	otherGroup := cursor.Status == cinema.SeatStatus_SEAT_STATUS_RESERVED && !cursor.Owned
+	for i := 0; i < rows; i++ {
+		for j := 0; j < columns; j++ {
+			for seat := range p.selected {
+				if p.near(i, j, seat[0], seat[1]) {
+					selection[[2]int{i, j}] = true
+					break
+				}
+			}
+			if !otherGroup {
+				continue
+			}
+			state := p.state(i, j)
+			if state.Status != cinema.SeatStatus_SEAT_STATUS_RESERVED || state.Owned {
+				continue
+			}
+			// without group names only the seat under the cursor is known to be of that group
+			if (cursor.GroupName != "" && state.GroupName != cursor.GroupName) || (cursor.GroupName == "" && (i != p.row || j != p.col)) {
+				continue
+			}
+			reachRows, reachCols := p.reach()
+			for k := max(0, i-reachRows); k <= min(rows-1, i+reachRows); k++ {
+				for l := max(0, j-reachCols); l <= min(columns-1, j+reachCols); l++ {
+					if p.near(i, j, k, l) {
+						other[[2]int{k, l}] = true
+					}
+				}
+			}
+		}
+	}
+	return selection, other
+}
+
+// conflict reports whether a selected seat cannot be taken by the group
+func (p *picker) conflict(row, col int) bool {
+	state := p.state(row, col)
+	switch state.Status {
+	case cinema.SeatStatus_SEAT_STATUS_RESERVED, cinema.SeatStatus_SEAT_STATUS_HELD:
+		return !state.Owned
+	case cinema.SeatStatus_SEAT_STATUS_BLOCKED_BY_DISTANCE, cinema.SeatStatus_SEAT_STATUS_UNSELLABLE:
+		return true
+	}
+	return false
+}
+
+func (p *picker) draw() {
+	var sb strings.Builder
+	sb.WriteString(ansiClear)
+	m := p.seatMap
+	fmt.Fprintf(&sb, "cinema %s, group %s, %d selected, min distance %d\n\n", p.id, p.group, len(p.selected), m.MinDistance)
+	if p.empty() {
+		sb.WriteString("no seats\n\ng refresh  q quit\n")
+		if p.message != "" {
+			sb.WriteString("\n" + p.message + "\n")
+		}
+		fmt.Fprint(p.cli.out, sb.String())
+		return
+	}
+
+	labelWidth := 1
+	for _, row := range m.SeatRows {
+		labelWidth = max(labelWidth, len(row.Label))
+	}
+	fmt.Fprintf(&sb, "%*s %s\n", labelWidth, "", center("SCREEN", 3*int(m.Columns)))
+	selectionZone, otherZone := p.zones()
+	for i, row := range m.SeatRows {
+		fmt.Fprintf(&sb, "%*s ", labelWidth, row.Label)
+		for j, state := range row.Seats {
+			symbol, color := seatSymbol(state)
+			seat := [2]int{i, j}
+			switch {
+			case p.selected[seat] && p.conflict(i, j):
+				symbol, color = "*", "\033[1;31m"
+			case p.selected[seat]:
+				symbol, color = "*", "\033[1;32m"
+			}
+			switch {
+			case otherZone[seat]:
+				color += "\033[45m"
+			case selectionZone[seat] && !p.selected[seat]:
+				color += "\033[44m"
+			}
+			if i == p.row && j == p.col {
+				color += "\033[7m"
+			}
+			fmt.Fprintf(&sb, "%s %s %s", color, symbol, ansiReset)
+		}
+		sb.WriteString("\n")
+	}
+
+	cursor := p.state(p.row, p.col)
+	fmt.Fprintf(&sb, "\nseat %s %s", cursor.Label, strings.ToLower(strings.TrimPrefix(cursor.Status.String(), "SEAT_STATUS_")))
+	if cursor.GroupName != "" {
+		fmt.Fprintf(&sb, " by %s", cursor.GroupName)
+	}
+	sb.WriteString("\n* selected (red: cannot be taken)  blue: zone of the selection  magenta: zone of the group under the cursor\n")
+	sb.WriteString("arrows/hjkl move  space select  r reserve  c cancel  u clear  g refresh  q quit\n")
+	if p.message != "" {
+		sb.WriteString("\n" + p.message + "\n")
+	}
+	fmt.Fprint(p.cli.out, sb.String())
+}
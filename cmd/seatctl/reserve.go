@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/t3201v/seat-arrangement/gen/cinema"
+)
+
+func runReserve(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("reserve")
+	id := fs.String("id", "", "id of the cinema")
+	group := fs.String("group", "", "name of the group")
+	accessible := fs.Bool("accessible", false, "the group needs wheelchair spaces")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *id == "" || *group == "" {
+		return errors.New("missing -id or -group")
+	}
+	seats, err := parseSeats(fs.Args())
+	if err != nil {
+		return err
+	}
+	if len(seats) == 0 {
+		return errors.New("no seat to reserve")
+	}
+
+	ctx, cancel := c.call(ctx)
+	defer cancel()
+	res, err := c.client.ReserveSeats(ctx, &cinema.ReserveSeatsRequest{
+		Id:         *id,
+		SeatCoords: seats,
+		GroupName:  *group,
+		Accessible: *accessible,
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(res, func() {
+		labels := make([]string, 0, len(res.Items))
+		for _, item := range res.Items {
+			labels = append(labels, seatLabel(item.Seat))
+		}
+		fmt.Fprintf(c.out, "reserved %s for %s, total %d %s\n", strings.Join(labels, ", "), *group, res.Total, res.Currency)
+	})
+}
+
+func runCancel(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("cancel")
+	id := fs.String("id", "", "id of the cinema")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *id == "" {
+		return errors.New("missing -id")
+	}
+	seats, err := parseSeats(fs.Args())
+	if err != nil {
+		return err
+	}
+	if len(seats) == 0 {
+		return errors.New("no seat to cancel")
+	}
+
+	ctx, cancel := c.call(ctx)
+	defer cancel()
+	res, err := c.client.CancelSeats(ctx, &cinema.CancelSeatsRequest{Id: *id, SeatCoords: seats})
+	if err != nil {
+		return err
+	}
+	return c.print(res, func() { fmt.Fprintf(c.out, "cancelled %d seats\n", len(seats)) })
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/t3201v/seat-arrangement/gen/cinema"
+)
+
+// parseSeat reads a seat given by label, e.g. F12, or by coordinates, e.g. 5:11
+func parseSeat(s string) (*cinema.Seat, error) {
+	row, col, ok := strings.Cut(s, ":")
+	if !ok {
+		if strings.TrimSpace(s) == "" {
+			return nil, fmt.Errorf("empty seat")
+		}
+		return &cinema.Seat{Label: s}, nil
+	}
+	r, err := strconv.Atoi(row)
+	if err != nil {
+		return nil, fmt.Errorf("malformed seat %q", s)
+	}
+	c, err := strconv.Atoi(col)
+	if err != nil {
+		return nil, fmt.Errorf("malformed seat %q", s)
+	}
+	return &cinema.Seat{Row: int32(r), Column: int32(c)}, nil
+}
+
+// parseSeats reads seats from arguments which may hold comma-separated lists
+func parseSeats(args []string) ([]*cinema.Seat, error) {
+	seats := make([]*cinema.Seat, 0, len(args))
+	for _, arg := range args {
+		for _, s := range strings.Split(arg, ",") {
+			seat, err := parseSeat(s)
+			if err != nil {
+				return nil, err
+			}
+			seats = append(seats, seat)
+		}
+	}
+	return seats, nil
+}
+
+func parseCategory(name string) (cinema.SeatCategory, error) {
+	value, ok := cinema.SeatCategory_value["SEAT_CATEGORY_"+strings.ToUpper(name)]
+	if !ok {
+		return 0, fmt.Errorf("unknown seat category %q", name)
+	}
+	return cinema.SeatCategory(value), nil
+}
+
+// seatLabel names a seat of a response, by its label when it has one
+func seatLabel(seat *cinema.Seat) string {
+	if seat.GetLabel() != "" {
+		return seat.GetLabel()
+	}
+	return fmt.Sprintf("%d:%d", seat.GetRow(), seat.GetColumn())
+}
+
+// listFlag collects the values of a flag given several times
+type listFlag []string
+
+func (l *listFlag) String() string {
+	return strings.Join(*l, " ")
+}
+
+func (l *listFlag) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
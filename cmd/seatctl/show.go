@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/t3201v/seat-arrangement/gen/cinema"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	ansiReset = "\033[0m"
+	ansiClear = "\033[H\033[2J"
+)
+
+var (
+	stateColors = map[cinema.SeatStatus]string{
+		cinema.SeatStatus_SEAT_STATUS_AVAILABLE:           "\033[32m",
+		cinema.SeatStatus_SEAT_STATUS_HELD:                "\033[33m",
+		cinema.SeatStatus_SEAT_STATUS_BLOCKED_BY_DISTANCE: "\033[90m",
+		cinema.SeatStatus_SEAT_STATUS_UNSELLABLE:          "\033[2m",
+	}
+	stateSymbols = map[cinema.SeatStatus]string{
+		cinema.SeatStatus_SEAT_STATUS_AVAILABLE:           ".",
+		cinema.SeatStatus_SEAT_STATUS_RESERVED:            "#",
+		cinema.SeatStatus_SEAT_STATUS_HELD:                "h",
+		cinema.SeatStatus_SEAT_STATUS_BLOCKED_BY_DISTANCE: "-",
+		cinema.SeatStatus_SEAT_STATUS_UNSELLABLE:          "X",
+	}
+
+	// reserved seats get a color of the palette from the hash of their group name
+	groupPalette = []string{"\033[31m", "\033[34m", "\033[35m", "\033[36m", "\033[91m", "\033[94m", "\033[95m", "\033[96m"}
+)
+
+func groupColor(groupName string) string {
+	h := fnv.New32a()
+	h.Write([]byte(groupName))
+	return groupPalette[h.Sum32()%uint32(len(groupPalette))]
+}
+
+// seatSymbol returns how a seat is drawn, reserved seats show the first letter of their group when known
+func seatSymbol(state *cinema.SeatState) (symbol, color string) {
+	symbol = stateSymbols[state.Status]
+	color = stateColors[state.Status]
+	if state.Status == cinema.SeatStatus_SEAT_STATUS_RESERVED {
+		color = "\033[1m"
+		if state.GroupName != "" {
+			symbol = strings.ToUpper(string([]rune(state.GroupName)[:1]))
+			color = groupColor(state.GroupName)
+		}
+		if state.Owned {
+			color += "\033[4m"
+		}
+	}
+	return symbol, color
+}
+
+// drawGrid writes the seat map as an ASCII grid with the screen on top
+func drawGrid(w io.Writer, m *cinema.GetSeatMapResponse, colored bool) {
+	labelWidth := 1
+	for _, row := range m.SeatRows {
+		labelWidth = max(labelWidth, len(row.Label))
+	}
+	cellWidth := len(fmt.Sprint(m.Columns)) + 1
+	gridWidth := int(m.Columns) * cellWidth
+
+	fmt.Fprintf(w, "%*s %s\n", labelWidth, "", center("SCREEN", gridWidth))
+	fmt.Fprintf(w, "%*s ", labelWidth, "")
+	for j := 1; j <= int(m.Columns); j++ {
+		fmt.Fprintf(w, "%*d", cellWidth, j)
+	}
+	fmt.Fprintln(w)
+
+	groups := make(map[string]int)
+	for _, row := range m.SeatRows {
+		fmt.Fprintf(w, "%*s ", labelWidth, row.Label)
+		for _, state := range row.Seats {
+			symbol, color := seatSymbol(state)
+			symbol = fmt.Sprintf("%*s", cellWidth, symbol)
+			if colored && color != "" {
+				symbol = color + symbol + ansiReset
+			}
+			fmt.Fprint(w, symbol)
+			if state.GroupName != "" {
+				groups[state.GroupName]++
+			}
+		}
+		fmt.Fprintln(w)
+	}
+
+	fmt.Fprint(w, "\n. available  # reserved  h held  - too close  X unsellable")
+	if len(m.ExclusionZone) == 0 {
+		fmt.Fprintf(w, "  (min distance %d)\n", m.MinDistance)
+	} else {
+		fmt.Fprintln(w, "\nexclusion zone around a reserved seat O:")
+		for _, row := range m.ExclusionZone {
+			fmt.Fprintf(w, "  %s\n", strings.Join(strings.Split(row, ""), " "))
+		}
+	}
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		label := name
+		if colored {
+			label = groupColor(name) + name + ansiReset
+		}
+		fmt.Fprintf(w, "  %s: %d seats\n", label, groups[name])
+	}
+}
+
+func center(s string, width int) string {
+	if len(s) >= width {
+		return s
+	}
+	pad := width - len(s)
+	return strings.Repeat("=", pad/2) + s + strings.Repeat("=", pad-pad/2)
+}
+
+func runShow(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("show")
+	id := fs.String("id", "", "id of the cinema")
+	group := fs.String("group", "", "group of the caller, its seats are underlined")
+	at := fs.String("at", "", "version, time or duration before now to show a past state")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *id == "" {
+		return errors.New("missing -id")
+	}
+	asOf, err := parsePoint(*at)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := c.call(ctx)
+	defer cancel()
+	res, err := c.client.GetSeatMap(ctx, &cinema.GetSeatMapRequest{Id: *id, GroupName: *group, AsOf: asOf})
+	if err != nil {
+		return err
+	}
+	return c.print(res, func() { drawGrid(c.out, res, c.color) })
+}
+
+// runWatch polls the seat map and redraws it when it changes, until interrupted.
+// With -json every change is printed as one line.
+func runWatch(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("watch")
+	id := fs.String("id", "", "id of the cinema")
+	group := fs.String("group", "", "group of the caller, its seats are underlined")
+	interval := fs.Duration("interval", 2*time.Second, "time between two polls")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *id == "" {
+		return errors.New("missing -id")
+	}
+	if *interval <= 0 {
+		return errors.New("-interval must be positive")
+	}
+
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+	var last *cinema.GetSeatMapResponse
+	for {
+		callCtx, cancel := c.call(ctx)
+		res, err := c.client.GetSeatMap(callCtx, &cinema.GetSeatMapRequest{Id: *id, GroupName: *group})
+		cancel()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		if last == nil || !proto.Equal(last, res) {
+			last = res
+			if c.json {
+				data, err := protojson.Marshal(res)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(c.out, string(data))
+			} else {
+				if c.color {
+					fmt.Fprint(c.out, ansiClear)
+				}
+				fmt.Fprintf(c.out, "cinema %s at %s\n\n", *id, time.Now().Format(time.TimeOnly))
+				drawGrid(c.out, res, c.color)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
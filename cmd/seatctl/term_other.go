@@ -0,0 +1,9 @@
+//go:build !linux && !darwin && !freebsd && !netbsd && !openbsd
+
+package main
+
+import "errors"
+
+func makeRaw(fd int) (func(), error) {
+	return nil, errors.New("the seat picker is not supported on this platform")
+}
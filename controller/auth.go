@@ -0,0 +1,38 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/t3201v/seat-arrangement/internal/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// AuthInterceptor rejects the calls without a known api key and binds the others to the
+// organization of their key, the service never trusts an organization told by the caller
+func AuthInterceptor(keys *auth.Keys) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		credentials := md.Get(auth.MetadataKey)
+		if len(credentials) != 1 {
+			return nil, status.Error(codes.Unauthenticated, "missing api key")
+		}
+		p, err := keys.Authenticate(credentials[0])
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		return handler(auth.NewContext(ctx, p), req)
+	}
+}
+
+// authenticate is AuthInterceptor for plain http requests
+func authenticate(keys *auth.Keys, r *http.Request) (context.Context, error) {
+	p, err := keys.Authenticate(r.Header.Get("Authorization"))
+	if err != nil {
+		return nil, err
+	}
+	return auth.NewContext(r.Context(), p), nil
+}
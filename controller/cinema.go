@@ -2,15 +2,25 @@ package controller
 
 import (
 	"context"
+	"errors"
+	"slices"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/t3201v/seat-arrangement/gen/cinema"
+	"github.com/t3201v/seat-arrangement/internal/auth"
 	"github.com/t3201v/seat-arrangement/internal/model"
+	"github.com/t3201v/seat-arrangement/internal/webhook"
+	"github.com/t3201v/seat-arrangement/repository"
 	"github.com/t3201v/seat-arrangement/service"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// roleMetadataKey asks for the role of the api key, callers get the view of a customer without it
+const roleMetadataKey = "x-role"
+
 type ICinema interface {
 	cinema.CinemaServiceServer
 }
@@ -24,7 +34,7 @@ type Cinema struct {
 func (c *Cinema) ConfigureCinema(ctx context.Context, request *cinema.ConfigureCinemaRequest) (*cinema.ConfigureCinemaResponse, error) {
 	id, err := c.svc.ConfigureCinema(ctx, request)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, errorStatus(err)
 	}
 	return &cinema.ConfigureCinemaResponse{
 		Id: id,
@@ -34,48 +44,332 @@ func (c *Cinema) ConfigureCinema(ctx context.Context, request *cinema.ConfigureC
 func (c *Cinema) UpdateCinemaConfig(ctx context.Context, request *cinema.UpdateCinemaConfigRequest) (*cinema.SuccessResponse, error) {
 	err := c.svc.UpdateCinemaConfig(ctx, request)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, errorStatus(err)
 	}
 	return &cinema.SuccessResponse{
 		Success: true,
 	}, nil
 }
 
-func (c *Cinema) GetAvailableSeats(ctx context.Context, request *cinema.GetAvailableSeatsRequest) (*cinema.GetAvailableSeatsResponse, error) {
-	data, grid, err := c.svc.GetAvailableSeats(ctx, request)
+func (c *Cinema) ExportCinema(ctx context.Context, request *cinema.ExportCinemaRequest) (*cinema.ExportCinemaResponse, error) {
+	data, err := c.svc.ExportCinema(ctx, request)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, errorStatus(err)
 	}
-	if len(data) == 0 {
-		return &cinema.GetAvailableSeatsResponse{}, nil
+	return &cinema.ExportCinemaResponse{Data: string(data)}, nil
+}
+
+func (c *Cinema) ImportCinema(ctx context.Context, request *cinema.ImportCinemaRequest) (*cinema.ConfigureCinemaResponse, error) {
+	id, err := c.svc.ImportCinema(ctx, request)
+	if err != nil {
+		return nil, errorStatus(err)
 	}
+	return &cinema.ConfigureCinemaResponse{Id: id}, nil
+}
 
-	result, err := new(model.Cinema).ToPbSeats(data)
+// ListAuditEvents is for staff only, the events name the groups and their seats
+func (c *Cinema) ListAuditEvents(ctx context.Context, request *cinema.ListAuditEventsRequest) (*cinema.ListAuditEventsResponse, error) {
+	if !isStaff(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "audit events are only listed for staff")
+	}
+	events, err := c.svc.ListAuditEvents(ctx, request)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, errorStatus(err)
+	}
+	res := &cinema.ListAuditEventsResponse{Events: make([]*cinema.AuditEvent, 0, len(events))}
+	for _, event := range events {
+		seats := make([]*cinema.Seat, 0, len(event.Seats))
+		for _, seat := range event.Seats {
+			seats = append(seats, &cinema.Seat{Row: int32(seat.Row), Column: int32(seat.Column), Label: seat.Label})
+		}
+		res.Events = append(res.Events, &cinema.AuditEvent{
+			RequestId: event.RequestID,
+			Time:      timestamppb.New(event.Time),
+			Actor:     event.Actor,
+			Operation: string(event.Operation),
+			CinemaId:  event.CinemaID,
+			GroupName: event.GroupName,
+			Seats:     seats,
+			Success:   event.Success,
+			Error:     event.Error,
+		})
 	}
+	return res, nil
+}
+
+func (c *Cinema) GetAvailableSeats(ctx context.Context, request *cinema.GetAvailableSeatsRequest) (*cinema.GetAvailableSeatsResponse, error) {
+	result, grid, err := c.svc.GetAvailableSeats(ctx, request)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+	if len(result) == 0 {
+		return &cinema.GetAvailableSeatsResponse{}, nil
+	}
+
 	return &cinema.GetAvailableSeatsResponse{
 		AvailableSeats: result,
 		Grid:           grid,
 	}, nil
 }
 
-func (c *Cinema) ReserveSeats(ctx context.Context, request *cinema.ReserveSeatsRequest) (*cinema.SuccessResponse, error) {
-	err := c.svc.ReserveSeats(ctx, request)
+func (c *Cinema) GetSeatMap(ctx context.Context, request *cinema.GetSeatMapRequest) (*cinema.GetSeatMapResponse, error) {
+	seatMap, err := c.svc.GetSeatMap(ctx, request)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, errorStatus(err)
 	}
-	return &cinema.SuccessResponse{Success: true}, nil
+	return seatMap.ToPb(request.GroupName, isStaff(ctx)), nil
+}
+
+func (c *Cinema) ListCinemaVersions(ctx context.Context, request *cinema.ListCinemaVersionsRequest) (*cinema.ListCinemaVersionsResponse, error) {
+	versions, err := c.svc.ListCinemaVersions(ctx, request)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+	res := &cinema.ListCinemaVersionsResponse{Versions: make([]*cinema.CinemaVersion, 0, len(versions))}
+	for _, version := range versions {
+		res.Versions = append(res.Versions, &cinema.CinemaVersion{
+			Version: int32(version.Number),
+			Time:    timestamppb.New(version.Time),
+			Event:   version.Event,
+		})
+	}
+	return res, nil
+}
+
+func (c *Cinema) DiffCinema(ctx context.Context, request *cinema.DiffCinemaRequest) (*cinema.DiffCinemaResponse, error) {
+	from, to, changes, err := c.svc.DiffCinema(ctx, request)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+	return &cinema.DiffCinemaResponse{
+		FromVersion: int32(from),
+		ToVersion:   int32(to),
+		Changes:     seatChangesToPb(changes, isStaff(ctx)),
+	}, nil
+}
+
+func (c *Cinema) RevertCinema(ctx context.Context, request *cinema.RevertCinemaRequest) (*cinema.RevertCinemaResponse, error) {
+	version, changes, err := c.svc.RevertCinema(ctx, request)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+	return &cinema.RevertCinemaResponse{
+		Version: int32(version),
+		Changes: seatChangesToPb(changes, isStaff(ctx)),
+	}, nil
+}
+
+func seatChangesToPb(changes []model.SeatChange, revealGroups bool) []*cinema.SeatChange {
+	result := make([]*cinema.SeatChange, 0, len(changes))
+	for _, change := range changes {
+		pbChange := &cinema.SeatChange{
+			Seat:       &cinema.Seat{Row: int32(change.Row), Column: int32(change.Col), Label: change.Label},
+			FromStatus: cinema.SeatStatus(change.From),
+			ToStatus:   cinema.SeatStatus(change.To),
+		}
+		if revealGroups {
+			pbChange.FromGroup = change.FromGroup
+			pbChange.ToGroup = change.ToGroup
+		}
+		result = append(result, pbChange)
+	}
+	return result
+}
+
+func (c *Cinema) ReserveSeats(ctx context.Context, request *cinema.ReserveSeatsRequest) (*cinema.ReserveSeatsResponse, error) {
+	breakdown, err := c.svc.ReserveSeats(ctx, request)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+	return breakdown.ToPb(), nil
 }
 
 func (c *Cinema) CancelSeats(ctx context.Context, request *cinema.CancelSeatsRequest) (*cinema.SuccessResponse, error) {
 	err := c.svc.CancelSeats(ctx, request)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, errorStatus(err)
+	}
+	return &cinema.SuccessResponse{Success: true}, nil
+}
+
+func (c *Cinema) BatchReserve(ctx context.Context, request *cinema.BatchReserveRequest) (*cinema.BatchReserveResponse, error) {
+	breakdowns, errs, err := c.svc.BatchReserve(ctx, request)
+	if errors.Is(err, model.ErrBatchRejected) {
+		results := make([]*cinema.GroupReservationResult, 0, len(request.Groups))
+		for i, group := range request.Groups {
+			result := &cinema.GroupReservationResult{GroupName: group.GroupName}
+			if errs[i] != nil {
+				result.Error = errs[i].Error()
+			}
+			results = append(results, result)
+		}
+		return &cinema.BatchReserveResponse{Results: results}, nil
+	}
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+
+	resp := &cinema.BatchReserveResponse{Success: true}
+	for i, breakdown := range breakdowns {
+		pb := breakdown.ToPb()
+		resp.Currency = pb.Currency
+		resp.Results = append(resp.Results, &cinema.GroupReservationResult{
+			GroupName: request.Groups[i].GroupName,
+			Items:     pb.Items,
+			Total:     pb.Total,
+		})
+	}
+	return resp, nil
+}
+
+func (c *Cinema) PlanSeating(ctx context.Context, request *cinema.PlanSeatingRequest) (*cinema.PlanSeatingResponse, error) {
+	plan, applied, err := c.svc.PlanSeating(ctx, request)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+
+	assignments := make([]*cinema.PartyAssignment, 0, len(plan.Assignments))
+	for _, assignment := range plan.Assignments {
+		assignments = append(assignments, &cinema.PartyAssignment{
+			GroupName: assignment.GroupName,
+			Seats:     assignment.Seats,
+		})
+	}
+	return &cinema.PlanSeatingResponse{
+		Assignments:   assignments,
+		Unseated:      plan.Unseated,
+		SeatedPeople:  int32(plan.SeatedPeople),
+		SeatedParties: int32(plan.SeatedParties),
+		Optimal:       plan.Optimal,
+		Applied:       applied,
+	}, nil
+}
+
+func (c *Cinema) AnalyzeCapacity(ctx context.Context, request *cinema.AnalyzeCapacityRequest) (*cinema.AnalyzeCapacityResponse, error) {
+	report, err := c.svc.AnalyzeCapacity(ctx, request)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+
+	scenarios := make([]*cinema.CapacityScenario, 0, len(report.Scenarios))
+	for _, scenario := range report.Scenarios {
+		groups := make([]*cinema.GroupCapacity, 0, len(scenario.Groups))
+		for _, group := range scenario.Groups {
+			groups = append(groups, &cinema.GroupCapacity{
+				GroupSize:       int32(group.GroupSize),
+				MaxGroups:       int32(group.MaxGroups),
+				RemainingGroups: int32(group.RemainingGroups),
+			})
+		}
+		scenarios = append(scenarios, &cinema.CapacityScenario{
+			MinDistance:    int32(scenario.MinDistance),
+			MaxSeats:       int32(scenario.MaxSeats),
+			RemainingSeats: int32(scenario.RemainingSeats),
+			DeadSeats:      int32(scenario.DeadSeats),
+			Groups:         groups,
+		})
+	}
+	return &cinema.AnalyzeCapacityResponse{
+		TotalSeats:    int32(report.TotalSeats),
+		ReservedSeats: int32(report.ReservedSeats),
+		Occupancy:     report.Occupancy(),
+		Scenarios:     scenarios,
+	}, nil
+}
+
+func (c *Cinema) SuggestSeats(ctx context.Context, request *cinema.SuggestSeatsRequest) (*cinema.SuggestSeatsResponse, error) {
+	result, err := c.svc.SuggestSeats(ctx, request)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+	return &cinema.SuggestSeatsResponse{Seats: result}, nil
+}
+
+func (c *Cinema) ExplainSeat(ctx context.Context, request *cinema.ExplainSeatRequest) (*cinema.ExplainSeatResponse, error) {
+	explanation, err := c.svc.ExplainSeat(ctx, request)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+	revealGroups := isStaff(ctx)
+	res := &cinema.ExplainSeatResponse{
+		Seat:       explanation.Seat,
+		Reservable: explanation.Reservable,
+		Conflicts:  make([]*cinema.SeatConflict, 0, len(explanation.Conflicts)),
+	}
+	for _, conflict := range explanation.Conflicts {
+		pb := &cinema.SeatConflict{
+			Reason:    cinema.ConflictReason(conflict.Reason),
+			OtherSeat: conflict.OtherSeat,
+			Distance:  int32(conflict.Distance),
+		}
+		if revealGroups || conflict.GroupName == request.GroupName {
+			pb.GroupName = conflict.GroupName
+		}
+		res.Conflicts = append(res.Conflicts, pb)
+	}
+	return res, nil
+}
+
+func (c *Cinema) ListCinemas(ctx context.Context, request *cinema.ListCinemasRequest) (*cinema.ListCinemasResponse, error) {
+	summaries, next, err := c.svc.ListCinemas(ctx, request)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+	res := &cinema.ListCinemasResponse{
+		Cinemas:       make([]*cinema.CinemaSummary, 0, len(summaries)),
+		NextPageToken: next,
+	}
+	for _, summary := range summaries {
+		res.Cinemas = append(res.Cinemas, &cinema.CinemaSummary{
+			Id:          summary.ID,
+			Name:        summary.Name,
+			Description: summary.Description,
+			Rows:        int32(summary.Rows),
+			Columns:     int32(summary.Columns),
+			Seats:       int32(summary.Seats),
+			Reserved:    int32(summary.Reserved),
+			Occupancy:   summary.Occupancy(),
+			LegacyId:    summary.LegacyID,
+		})
+	}
+	return res, nil
+}
+
+func (c *Cinema) DeleteCinema(ctx context.Context, request *cinema.DeleteCinemaRequest) (*cinema.SuccessResponse, error) {
+	err := c.svc.DeleteCinema(ctx, request)
+	if err != nil {
+		return nil, errorStatus(err)
 	}
 	return &cinema.SuccessResponse{Success: true}, nil
 }
 
+// errorStatus returns the status of a failed call, malformed requests are invalid arguments
+// and unknown cinemas, versions, venues or webhooks are not found
+func errorStatus(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidArgument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, repository.ErrNotFound), errors.Is(err, webhook.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// isStaff reports whether the caller acts as staff: its api key has the staff role and it asks
+// for it, http clients with the Grpc-Metadata-X-Role header
+func isStaff(ctx context.Context) bool {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return actsAsStaff(ctx, md.Get(roleMetadataKey))
+}
+
+// actsAsStaff reports whether the caller has the staff role and asks for it in roles
+func actsAsStaff(ctx context.Context, roles []string) bool {
+	if p, ok := auth.FromContext(ctx); !ok || p.Role != auth.RoleStaff {
+		return false
+	}
+	return slices.Contains(roles, auth.RoleStaff)
+}
+
 func NewCinema(l *log.Logger, svc service.ICinema) ICinema {
 	return &Cinema{
 		logger: l,
@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/t3201v/seat-arrangement/gen/cinema"
+	"github.com/t3201v/seat-arrangement/internal/model"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (c *Cinema) ListDistancingPolicies(ctx context.Context, request *cinema.ListDistancingPoliciesRequest) (*cinema.ListDistancingPoliciesResponse, error) {
+	policies, current, err := c.svc.ListDistancingPolicies(ctx, request)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+	res := &cinema.ListDistancingPoliciesResponse{Policies: make([]*cinema.DistancingPolicy, 0, len(policies))}
+	for _, policy := range policies {
+		res.Policies = append(res.Policies, policyToPb(policy))
+	}
+	if current != nil {
+		res.Current = policyToPb(*current)
+	}
+	return res, nil
+}
+
+func (c *Cinema) GetPolicyViolations(ctx context.Context, request *cinema.GetPolicyViolationsRequest) (*cinema.GetPolicyViolationsResponse, error) {
+	policy, violations, err := c.svc.GetPolicyViolations(ctx, request)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+	revealGroups := isStaff(ctx)
+	res := &cinema.GetPolicyViolationsResponse{
+		Policy:     policyToPb(policy),
+		Violations: make([]*cinema.PolicyViolation, 0, len(violations)),
+	}
+	for _, violation := range violations {
+		pb := &cinema.PolicyViolation{Seats: violation.Seats}
+		if revealGroups {
+			pb.GroupName = violation.GroupName
+			pb.TooCloseTo = violation.TooCloseTo
+		}
+		res.Violations = append(res.Violations, pb)
+	}
+	return res, nil
+}
+
+func policyToPb(policy model.DistancingPolicy) *cinema.DistancingPolicy {
+	pb := &cinema.DistancingPolicy{
+		EffectiveFrom: timestamppb.New(policy.EffectiveFrom),
+		MinDistance:   int32(policy.MinDistance),
+	}
+	if !policy.Separation.IsZero() {
+		pb.Separation = &cinema.SeparationProfile{
+			Horizontal: int32(policy.Separation.Horizontal),
+			Vertical:   int32(policy.Separation.Vertical),
+			Diagonal:   int32(policy.Separation.Diagonal),
+			Mask:       policy.Separation.Mask,
+		}
+	}
+	return pb
+}
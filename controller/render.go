@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	log "github.com/sirupsen/logrus"
+	"github.com/t3201v/seat-arrangement/gen/cinema"
+	"github.com/t3201v/seat-arrangement/internal/auth"
+	"github.com/t3201v/seat-arrangement/internal/render"
+	"github.com/t3201v/seat-arrangement/service"
+	"google.golang.org/grpc/status"
+)
+
+// Render serves seat maps as images over plain http, e.g.
+// GET /api/v1/cinema/seat/map.svg?id=01JB8Z6Q2WXE4R5T6Y7M8N9P0K&overlay=true
+type Render struct {
+	svc    service.ICinema
+	keys   *auth.Keys
+	logger *log.Logger
+}
+
+func (c *Render) ServeSVG(w http.ResponseWriter, r *http.Request) {
+	c.serve(w, r, "image/svg+xml", render.SVG)
+}
+
+func (c *Render) ServePNG(w http.ResponseWriter, r *http.Request) {
+	c.serve(w, r, "image/png", render.PNG)
+}
+
+func (c *Render) serve(w http.ResponseWriter, r *http.Request, contentType string, draw render.Func) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	ctx, err := authenticate(c.keys, r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	query := r.URL.Query()
+	seatMap, err := c.svc.GetSeatMap(ctx, &cinema.GetSeatMapRequest{
+		Id:        query.Get("id"),
+		GroupName: query.Get("group_name"),
+	})
+	if err != nil {
+		http.Error(w, err.Error(), runtime.HTTPStatusFromCode(status.Code(errorStatus(err))))
+		return
+	}
+
+	opts := render.Options{
+		Overlay:    query.Get("overlay") == "true",
+		ShowGroups: isStaffRequest(ctx, r),
+	}
+	// drawn in full before anything is sent, a failure is not a truncated image
+	var buf bytes.Buffer
+	err = draw(&buf, seatMap, opts)
+	if errors.Is(err, render.ErrTooLarge) {
+		http.Error(w, err.Error()+", use the svg", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		c.logger.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	if _, err := buf.WriteTo(w); err != nil {
+		c.logger.Error(err)
+	}
+}
+
+// isStaffRequest is isStaff for plain http requests
+func isStaffRequest(ctx context.Context, r *http.Request) bool {
+	return actsAsStaff(ctx, []string{r.Header.Get(roleMetadataKey), r.Header.Get("Grpc-Metadata-" + roleMetadataKey)})
+}
+
+func NewRender(l *log.Logger, svc service.ICinema, keys *auth.Keys) *Render {
+	return &Render{
+		logger: l,
+		svc:    svc,
+		keys:   keys,
+	}
+}
@@ -0,0 +1,47 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/t3201v/seat-arrangement/gen/cinema"
+	"github.com/t3201v/seat-arrangement/internal/model"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func venueToPb(venue *model.Venue) *cinema.Venue {
+	return &cinema.Venue{
+		Id:        venue.ID,
+		Name:      venue.Name,
+		Address:   venue.Address,
+		HallIds:   venue.Halls,
+		CreatedAt: timestamppb.New(venue.CreatedAt),
+	}
+}
+
+func (c *Cinema) CreateVenue(ctx context.Context, request *cinema.CreateVenueRequest) (*cinema.Venue, error) {
+	venue, err := c.svc.CreateVenue(ctx, request)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+	return venueToPb(venue), nil
+}
+
+func (c *Cinema) ListVenues(ctx context.Context, request *cinema.ListVenuesRequest) (*cinema.ListVenuesResponse, error) {
+	venues, err := c.svc.ListVenues(ctx, request)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+	res := &cinema.ListVenuesResponse{Venues: make([]*cinema.Venue, 0, len(venues))}
+	for i := range venues {
+		res.Venues = append(res.Venues, venueToPb(&venues[i]))
+	}
+	return res, nil
+}
+
+func (c *Cinema) DeleteVenue(ctx context.Context, request *cinema.DeleteVenueRequest) (*cinema.SuccessResponse, error) {
+	err := c.svc.DeleteVenue(ctx, request)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+	return &cinema.SuccessResponse{Success: true}, nil
+}
@@ -0,0 +1,91 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/t3201v/seat-arrangement/gen/cinema"
+	"github.com/t3201v/seat-arrangement/internal/model"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (c *Cinema) JoinWaitlist(ctx context.Context, request *cinema.JoinWaitlistRequest) (*cinema.WaitlistPositionResponse, error) {
+	position, waiting, err := c.svc.JoinWaitlist(ctx, request)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+	return &cinema.WaitlistPositionResponse{Position: int32(position), Waiting: int32(waiting)}, nil
+}
+
+func (c *Cinema) LeaveWaitlist(ctx context.Context, request *cinema.WaitlistGroupRequest) (*cinema.SuccessResponse, error) {
+	err := c.svc.LeaveWaitlist(ctx, request)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+	return &cinema.SuccessResponse{Success: true}, nil
+}
+
+func (c *Cinema) GetWaitlistPosition(ctx context.Context, request *cinema.WaitlistGroupRequest) (*cinema.WaitlistPositionResponse, error) {
+	position, waiting, hold, err := c.svc.GetWaitlistPosition(ctx, request)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+	res := &cinema.WaitlistPositionResponse{Position: int32(position), Waiting: int32(waiting)}
+	if hold != nil {
+		res.Hold = holdToPb(hold, true)
+	}
+	return res, nil
+}
+
+func (c *Cinema) GetWaitlist(ctx context.Context, request *cinema.GetWaitlistRequest) (*cinema.GetWaitlistResponse, error) {
+	entries, holds, err := c.svc.GetWaitlist(ctx, request)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+	revealGroups := isStaff(ctx)
+	res := &cinema.GetWaitlistResponse{
+		Entries: make([]*cinema.WaitlistEntry, 0, len(entries)),
+		Holds:   make([]*cinema.SeatHold, 0, len(holds)),
+	}
+	for _, entry := range entries {
+		pb := &cinema.WaitlistEntry{
+			Size:       int32(entry.Size),
+			Accessible: entry.Accessible,
+			AutoHold:   entry.AutoHold,
+			HoldFor:    durationpb.New(entry.HoldFor),
+			JoinedAt:   timestamppb.New(entry.JoinedAt),
+		}
+		if revealGroups {
+			pb.GroupName = entry.GroupName
+		}
+		res.Entries = append(res.Entries, pb)
+	}
+	for i := range holds {
+		res.Holds = append(res.Holds, holdToPb(&holds[i], revealGroups))
+	}
+	return res, nil
+}
+
+func (c *Cinema) ConfirmHold(ctx context.Context, request *cinema.WaitlistGroupRequest) (*cinema.SuccessResponse, error) {
+	err := c.svc.ConfirmHold(ctx, request)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+	return &cinema.SuccessResponse{Success: true}, nil
+}
+
+func (c *Cinema) ReleaseHold(ctx context.Context, request *cinema.WaitlistGroupRequest) (*cinema.SuccessResponse, error) {
+	err := c.svc.ReleaseHold(ctx, request)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+	return &cinema.SuccessResponse{Success: true}, nil
+}
+
+func holdToPb(hold *model.Hold, revealGroup bool) *cinema.SeatHold {
+	pb := &cinema.SeatHold{Seats: hold.Seats, Until: timestamppb.New(hold.Until)}
+	if revealGroup {
+		pb.GroupName = hold.GroupName
+	}
+	return pb
+}
@@ -0,0 +1,82 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/t3201v/seat-arrangement/gen/cinema"
+	"github.com/t3201v/seat-arrangement/internal/webhook"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func webhookToPb(s *webhook.Subscription) *cinema.Webhook {
+	return &cinema.Webhook{
+		Id:         s.ID,
+		Url:        s.URL,
+		EventTypes: s.EventTypes,
+		CinemaId:   s.CinemaID,
+		CreatedAt:  timestamppb.New(s.CreatedAt),
+	}
+}
+
+func (c *Cinema) CreateWebhook(ctx context.Context, request *cinema.CreateWebhookRequest) (*cinema.Webhook, error) {
+	subscription, err := c.svc.CreateWebhook(ctx, request)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+	return webhookToPb(subscription), nil
+}
+
+func (c *Cinema) ListWebhooks(ctx context.Context, request *cinema.ListWebhooksRequest) (*cinema.ListWebhooksResponse, error) {
+	subscriptions, err := c.svc.ListWebhooks(ctx, request)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+	res := &cinema.ListWebhooksResponse{Webhooks: make([]*cinema.Webhook, 0, len(subscriptions))}
+	for i := range subscriptions {
+		res.Webhooks = append(res.Webhooks, webhookToPb(&subscriptions[i]))
+	}
+	return res, nil
+}
+
+func (c *Cinema) DeleteWebhook(ctx context.Context, request *cinema.DeleteWebhookRequest) (*cinema.SuccessResponse, error) {
+	err := c.svc.DeleteWebhook(ctx, request)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+	return &cinema.SuccessResponse{Success: true}, nil
+}
+
+func (c *Cinema) ListWebhookDeliveries(ctx context.Context, request *cinema.ListWebhookDeliveriesRequest) (*cinema.ListWebhookDeliveriesResponse, error) {
+	deliveries, err := c.svc.ListWebhookDeliveries(ctx, request)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+	res := &cinema.ListWebhookDeliveriesResponse{Deliveries: make([]*cinema.WebhookDelivery, 0, len(deliveries))}
+	for _, delivery := range deliveries {
+		pb := &cinema.WebhookDelivery{
+			Id:        delivery.ID,
+			WebhookId: delivery.SubscriptionID,
+			EventType: delivery.EventType,
+			CinemaId:  delivery.CinemaID,
+			Status:    cinema.DeliveryStatus(delivery.Status + 1),
+			Attempts:  int32(delivery.Attempts),
+			LastError: delivery.LastError,
+			CreatedAt: timestamppb.New(delivery.CreatedAt),
+			UpdatedAt: timestamppb.New(delivery.UpdatedAt),
+			Payload:   string(delivery.Payload),
+		}
+		if delivery.Status == webhook.StatusPending {
+			pb.NextAttempt = timestamppb.New(delivery.NextAttempt)
+		}
+		res.Deliveries = append(res.Deliveries, pb)
+	}
+	return res, nil
+}
+
+func (c *Cinema) RetryWebhookDelivery(ctx context.Context, request *cinema.RetryWebhookDeliveryRequest) (*cinema.SuccessResponse, error) {
+	err := c.svc.RetryWebhookDelivery(ctx, request)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+	return &cinema.SuccessResponse{Success: true}, nil
+}
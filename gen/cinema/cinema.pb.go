@@ -12,6 +12,8 @@ import (
 	_ "github.com/t3201v/seat-arrangement/gen/protoc-gen-openapiv2/options"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -23,32 +25,5357 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type LayoutFormat int32
+
+const (
+	LayoutFormat_LAYOUT_FORMAT_JSON LayoutFormat = 0
+	LayoutFormat_LAYOUT_FORMAT_YAML LayoutFormat = 1
+	LayoutFormat_LAYOUT_FORMAT_CSV  LayoutFormat = 2 // Grid of seats without the other settings
+)
+
+// Enum value maps for LayoutFormat.
+var (
+	LayoutFormat_name = map[int32]string{
+		0: "LAYOUT_FORMAT_JSON",
+		1: "LAYOUT_FORMAT_YAML",
+		2: "LAYOUT_FORMAT_CSV",
+	}
+	LayoutFormat_value = map[string]int32{
+		"LAYOUT_FORMAT_JSON": 0,
+		"LAYOUT_FORMAT_YAML": 1,
+		"LAYOUT_FORMAT_CSV":  2,
+	}
+)
+
+func (x LayoutFormat) Enum() *LayoutFormat {
+	p := new(LayoutFormat)
+	*p = x
+	return p
+}
+
+func (x LayoutFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LayoutFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_cinema_cinema_proto_enumTypes[0].Descriptor()
+}
+
+func (LayoutFormat) Type() protoreflect.EnumType {
+	return &file_cinema_cinema_proto_enumTypes[0]
+}
+
+func (x LayoutFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LayoutFormat.Descriptor instead.
+func (LayoutFormat) EnumDescriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{0}
+}
+
+type DeliveryStatus int32
+
+const (
+	DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED DeliveryStatus = 0
+	DeliveryStatus_DELIVERY_STATUS_PENDING     DeliveryStatus = 1
+	DeliveryStatus_DELIVERY_STATUS_DELIVERED   DeliveryStatus = 2
+	DeliveryStatus_DELIVERY_STATUS_DEAD        DeliveryStatus = 3 // Every attempt failed
+)
+
+// Enum value maps for DeliveryStatus.
+var (
+	DeliveryStatus_name = map[int32]string{
+		0: "DELIVERY_STATUS_UNSPECIFIED",
+		1: "DELIVERY_STATUS_PENDING",
+		2: "DELIVERY_STATUS_DELIVERED",
+		3: "DELIVERY_STATUS_DEAD",
+	}
+	DeliveryStatus_value = map[string]int32{
+		"DELIVERY_STATUS_UNSPECIFIED": 0,
+		"DELIVERY_STATUS_PENDING":     1,
+		"DELIVERY_STATUS_DELIVERED":   2,
+		"DELIVERY_STATUS_DEAD":        3,
+	}
+)
+
+func (x DeliveryStatus) Enum() *DeliveryStatus {
+	p := new(DeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x DeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_cinema_cinema_proto_enumTypes[1].Descriptor()
+}
+
+func (DeliveryStatus) Type() protoreflect.EnumType {
+	return &file_cinema_cinema_proto_enumTypes[1]
+}
+
+func (x DeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeliveryStatus.Descriptor instead.
+func (DeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{1}
+}
+
+type SeatStatus int32
+
+const (
+	SeatStatus_SEAT_STATUS_AVAILABLE           SeatStatus = 0
+	SeatStatus_SEAT_STATUS_RESERVED            SeatStatus = 1
+	SeatStatus_SEAT_STATUS_HELD                SeatStatus = 2 // Temporarily kept for a group
+	SeatStatus_SEAT_STATUS_BLOCKED_BY_DISTANCE SeatStatus = 3 // Free but too close to another group
+	SeatStatus_SEAT_STATUS_UNSELLABLE          SeatStatus = 4
+)
+
+// Enum value maps for SeatStatus.
+var (
+	SeatStatus_name = map[int32]string{
+		0: "SEAT_STATUS_AVAILABLE",
+		1: "SEAT_STATUS_RESERVED",
+		2: "SEAT_STATUS_HELD",
+		3: "SEAT_STATUS_BLOCKED_BY_DISTANCE",
+		4: "SEAT_STATUS_UNSELLABLE",
+	}
+	SeatStatus_value = map[string]int32{
+		"SEAT_STATUS_AVAILABLE":           0,
+		"SEAT_STATUS_RESERVED":            1,
+		"SEAT_STATUS_HELD":                2,
+		"SEAT_STATUS_BLOCKED_BY_DISTANCE": 3,
+		"SEAT_STATUS_UNSELLABLE":          4,
+	}
+)
+
+func (x SeatStatus) Enum() *SeatStatus {
+	p := new(SeatStatus)
+	*p = x
+	return p
+}
+
+func (x SeatStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SeatStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_cinema_cinema_proto_enumTypes[2].Descriptor()
+}
+
+func (SeatStatus) Type() protoreflect.EnumType {
+	return &file_cinema_cinema_proto_enumTypes[2]
+}
+
+func (x SeatStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SeatStatus.Descriptor instead.
+func (SeatStatus) EnumDescriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{2}
+}
+
+type PlanObjective int32
+
+const (
+	PlanObjective_PLAN_OBJECTIVE_PEOPLE  PlanObjective = 0 // Maximize the number of people seated
+	PlanObjective_PLAN_OBJECTIVE_PARTIES PlanObjective = 1 // Maximize the number of parties seated
+)
+
+// Enum value maps for PlanObjective.
+var (
+	PlanObjective_name = map[int32]string{
+		0: "PLAN_OBJECTIVE_PEOPLE",
+		1: "PLAN_OBJECTIVE_PARTIES",
+	}
+	PlanObjective_value = map[string]int32{
+		"PLAN_OBJECTIVE_PEOPLE":  0,
+		"PLAN_OBJECTIVE_PARTIES": 1,
+	}
+)
+
+func (x PlanObjective) Enum() *PlanObjective {
+	p := new(PlanObjective)
+	*p = x
+	return p
+}
+
+func (x PlanObjective) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlanObjective) Descriptor() protoreflect.EnumDescriptor {
+	return file_cinema_cinema_proto_enumTypes[3].Descriptor()
+}
+
+func (PlanObjective) Type() protoreflect.EnumType {
+	return &file_cinema_cinema_proto_enumTypes[3]
+}
+
+func (x PlanObjective) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlanObjective.Descriptor instead.
+func (PlanObjective) EnumDescriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{3}
+}
+
+type ConflictReason int32
+
+const (
+	ConflictReason_CONFLICT_REASON_UNSPECIFIED  ConflictReason = 0
+	ConflictReason_CONFLICT_REASON_OUT_OF_RANGE ConflictReason = 1
+	ConflictReason_CONFLICT_REASON_RESERVED     ConflictReason = 2 // Already reserved, by the group itself or another one
+	ConflictReason_CONFLICT_REASON_UNSELLABLE   ConflictReason = 3
+	ConflictReason_CONFLICT_REASON_TOO_CLOSE    ConflictReason = 4 // Too close to a seat of another group
+)
+
+// Enum value maps for ConflictReason.
+var (
+	ConflictReason_name = map[int32]string{
+		0: "CONFLICT_REASON_UNSPECIFIED",
+		1: "CONFLICT_REASON_OUT_OF_RANGE",
+		2: "CONFLICT_REASON_RESERVED",
+		3: "CONFLICT_REASON_UNSELLABLE",
+		4: "CONFLICT_REASON_TOO_CLOSE",
+	}
+	ConflictReason_value = map[string]int32{
+		"CONFLICT_REASON_UNSPECIFIED":  0,
+		"CONFLICT_REASON_OUT_OF_RANGE": 1,
+		"CONFLICT_REASON_RESERVED":     2,
+		"CONFLICT_REASON_UNSELLABLE":   3,
+		"CONFLICT_REASON_TOO_CLOSE":    4,
+	}
+)
+
+func (x ConflictReason) Enum() *ConflictReason {
+	p := new(ConflictReason)
+	*p = x
+	return p
+}
+
+func (x ConflictReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConflictReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_cinema_cinema_proto_enumTypes[4].Descriptor()
+}
+
+func (ConflictReason) Type() protoreflect.EnumType {
+	return &file_cinema_cinema_proto_enumTypes[4]
+}
+
+func (x ConflictReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConflictReason.Descriptor instead.
+func (ConflictReason) EnumDescriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{4}
+}
+
+type Adjacency int32
+
+const (
+	Adjacency_ADJACENCY_ANY       Adjacency = 0 // Seats of a group may be anywhere
+	Adjacency_ADJACENCY_SAME_ROW  Adjacency = 1 // Consecutive seats of a single row
+	Adjacency_ADJACENCY_CONNECTED Adjacency = 2 // Every seat next to another one of the group, up, down, left or right
+)
+
+// Enum value maps for Adjacency.
+var (
+	Adjacency_name = map[int32]string{
+		0: "ADJACENCY_ANY",
+		1: "ADJACENCY_SAME_ROW",
+		2: "ADJACENCY_CONNECTED",
+	}
+	Adjacency_value = map[string]int32{
+		"ADJACENCY_ANY":       0,
+		"ADJACENCY_SAME_ROW":  1,
+		"ADJACENCY_CONNECTED": 2,
+	}
+)
+
+func (x Adjacency) Enum() *Adjacency {
+	p := new(Adjacency)
+	*p = x
+	return p
+}
+
+func (x Adjacency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Adjacency) Descriptor() protoreflect.EnumDescriptor {
+	return file_cinema_cinema_proto_enumTypes[5].Descriptor()
+}
+
+func (Adjacency) Type() protoreflect.EnumType {
+	return &file_cinema_cinema_proto_enumTypes[5]
+}
+
+func (x Adjacency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Adjacency.Descriptor instead.
+func (Adjacency) EnumDescriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{5}
+}
+
+type RowLabelStyle int32
+
+const (
+	RowLabelStyle_ROW_LABEL_STYLE_LETTERS RowLabelStyle = 0 // A, B, ..., Z, AA, AB... from the screen, e.g. "F12"
+	RowLabelStyle_ROW_LABEL_STYLE_NUMBERS RowLabelStyle = 1 // 1, 2, 3... from the screen, e.g. "6-12"
+)
+
+// Enum value maps for RowLabelStyle.
+var (
+	RowLabelStyle_name = map[int32]string{
+		0: "ROW_LABEL_STYLE_LETTERS",
+		1: "ROW_LABEL_STYLE_NUMBERS",
+	}
+	RowLabelStyle_value = map[string]int32{
+		"ROW_LABEL_STYLE_LETTERS": 0,
+		"ROW_LABEL_STYLE_NUMBERS": 1,
+	}
+)
+
+func (x RowLabelStyle) Enum() *RowLabelStyle {
+	p := new(RowLabelStyle)
+	*p = x
+	return p
+}
+
+func (x RowLabelStyle) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RowLabelStyle) Descriptor() protoreflect.EnumDescriptor {
+	return file_cinema_cinema_proto_enumTypes[6].Descriptor()
+}
+
+func (RowLabelStyle) Type() protoreflect.EnumType {
+	return &file_cinema_cinema_proto_enumTypes[6]
+}
+
+func (x RowLabelStyle) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RowLabelStyle.Descriptor instead.
+func (RowLabelStyle) EnumDescriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{6}
+}
+
+type SeatNumbering int32
+
+const (
+	SeatNumbering_SEAT_NUMBERING_LEFT_TO_RIGHT SeatNumbering = 0 // 1, 2, 3... from the left
+	SeatNumbering_SEAT_NUMBERING_RIGHT_TO_LEFT SeatNumbering = 1 // 1, 2, 3... from the right
+	SeatNumbering_SEAT_NUMBERING_FROM_CENTER   SeatNumbering = 2 // 1, 2, 3... from the center on each side, prefixed by L or R, e.g. "FL3"
+	SeatNumbering_SEAT_NUMBERING_ODD_EVEN      SeatNumbering = 3 // From the center, odd numbers on the left side and even ones on the right
+)
+
+// Enum value maps for SeatNumbering.
+var (
+	SeatNumbering_name = map[int32]string{
+		0: "SEAT_NUMBERING_LEFT_TO_RIGHT",
+		1: "SEAT_NUMBERING_RIGHT_TO_LEFT",
+		2: "SEAT_NUMBERING_FROM_CENTER",
+		3: "SEAT_NUMBERING_ODD_EVEN",
+	}
+	SeatNumbering_value = map[string]int32{
+		"SEAT_NUMBERING_LEFT_TO_RIGHT": 0,
+		"SEAT_NUMBERING_RIGHT_TO_LEFT": 1,
+		"SEAT_NUMBERING_FROM_CENTER":   2,
+		"SEAT_NUMBERING_ODD_EVEN":      3,
+	}
+)
+
+func (x SeatNumbering) Enum() *SeatNumbering {
+	p := new(SeatNumbering)
+	*p = x
+	return p
+}
+
+func (x SeatNumbering) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SeatNumbering) Descriptor() protoreflect.EnumDescriptor {
+	return file_cinema_cinema_proto_enumTypes[7].Descriptor()
+}
+
+func (SeatNumbering) Type() protoreflect.EnumType {
+	return &file_cinema_cinema_proto_enumTypes[7]
+}
+
+func (x SeatNumbering) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SeatNumbering.Descriptor instead.
+func (SeatNumbering) EnumDescriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{7}
+}
+
+type SeatCategory int32
+
+const (
+	SeatCategory_SEAT_CATEGORY_STANDARD   SeatCategory = 0
+	SeatCategory_SEAT_CATEGORY_PREMIUM    SeatCategory = 1
+	SeatCategory_SEAT_CATEGORY_VIP        SeatCategory = 2
+	SeatCategory_SEAT_CATEGORY_WHEELCHAIR SeatCategory = 3
+	SeatCategory_SEAT_CATEGORY_COMPANION  SeatCategory = 4
+)
+
+// Enum value maps for SeatCategory.
+var (
+	SeatCategory_name = map[int32]string{
+		0: "SEAT_CATEGORY_STANDARD",
+		1: "SEAT_CATEGORY_PREMIUM",
+		2: "SEAT_CATEGORY_VIP",
+		3: "SEAT_CATEGORY_WHEELCHAIR",
+		4: "SEAT_CATEGORY_COMPANION",
+	}
+	SeatCategory_value = map[string]int32{
+		"SEAT_CATEGORY_STANDARD":   0,
+		"SEAT_CATEGORY_PREMIUM":    1,
+		"SEAT_CATEGORY_VIP":        2,
+		"SEAT_CATEGORY_WHEELCHAIR": 3,
+		"SEAT_CATEGORY_COMPANION":  4,
+	}
+)
+
+func (x SeatCategory) Enum() *SeatCategory {
+	p := new(SeatCategory)
+	*p = x
+	return p
+}
+
+func (x SeatCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SeatCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_cinema_cinema_proto_enumTypes[8].Descriptor()
+}
+
+func (SeatCategory) Type() protoreflect.EnumType {
+	return &file_cinema_cinema_proto_enumTypes[8]
+}
+
+func (x SeatCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SeatCategory.Descriptor instead.
+func (SeatCategory) EnumDescriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{8}
+}
+
 // Message to configure the cinema layout and distancing rules
 type ConfigureCinemaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rows        int32 `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`                                  // Number of rows in the cinema
-	Columns     int32 `protobuf:"varint,2,opt,name=columns,proto3" json:"columns,omitempty"`                            // Number of columns in the cinema
-	MinDistance int32 `protobuf:"varint,3,opt,name=min_distance,json=minDistance,proto3" json:"min_distance,omitempty"` // Minimum Manhattan distance between groups
+	Rows              int32                  `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`                                                   // Number of rows in the cinema, 1 to 500
+	Columns           int32                  `protobuf:"varint,2,opt,name=columns,proto3" json:"columns,omitempty"`                                             // Number of columns in the cinema, 1 to 500
+	MinDistance       int32                  `protobuf:"varint,3,opt,name=min_distance,json=minDistance,proto3" json:"min_distance,omitempty"`                  // Minimum Manhattan distance between groups
+	Categories        []*CategoryAssignment  `protobuf:"bytes,4,rep,name=categories,proto3" json:"categories,omitempty"`                                        // Seat categories, seats not listed are standard
+	Prices            []*CategoryPrice       `protobuf:"bytes,5,rep,name=prices,proto3" json:"prices,omitempty"`                                                // Price table of the cinema
+	Currency          string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`                                            // Currency of the price table
+	ShowTime          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=show_time,json=showTime,proto3" json:"show_time,omitempty"`                            // Start of the show
+	AccessibleRelease *durationpb.Duration   `protobuf:"bytes,8,opt,name=accessible_release,json=accessibleRelease,proto3" json:"accessible_release,omitempty"` // Unsold accessible seats go to general sale this long before the show
+	UnsellableSeats   []*Seat                `protobuf:"bytes,9,rep,name=unsellable_seats,json=unsellableSeats,proto3" json:"unsellable_seats,omitempty"`       // Seats that can never be reserved, e.g. broken ones
+	Labels            *LabelScheme           `protobuf:"bytes,10,opt,name=labels,proto3" json:"labels,omitempty"`                                               // How seats are labeled, seats of other fields may be given by label
+	Adjacency         *AdjacencyPolicy       `protobuf:"bytes,11,opt,name=adjacency,proto3" json:"adjacency,omitempty"`                                         // How the seats of a group sit together, anywhere by default
+	Separation        *SeparationProfile     `protobuf:"bytes,12,opt,name=separation,proto3" json:"separation,omitempty"`                                       // Replaces min_distance when set
+	Policies          []*DistancingPolicy    `protobuf:"bytes,13,rep,name=policies,proto3" json:"policies,omitempty"`                                           // Distancing rules replacing min_distance and separation from their effective time
+	VenueId           string                 `protobuf:"bytes,14,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`                              // Venue the cinema is a hall of, if any
+	Name              string                 `protobuf:"bytes,15,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string                 `protobuf:"bytes,16,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *ConfigureCinemaRequest) Reset() {
+	*x = ConfigureCinemaRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigureCinemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureCinemaRequest) ProtoMessage() {}
+
+func (x *ConfigureCinemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureCinemaRequest.ProtoReflect.Descriptor instead.
+func (*ConfigureCinemaRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConfigureCinemaRequest) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *ConfigureCinemaRequest) GetColumns() int32 {
+	if x != nil {
+		return x.Columns
+	}
+	return 0
+}
+
+func (x *ConfigureCinemaRequest) GetMinDistance() int32 {
+	if x != nil {
+		return x.MinDistance
+	}
+	return 0
+}
+
+func (x *ConfigureCinemaRequest) GetCategories() []*CategoryAssignment {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *ConfigureCinemaRequest) GetPrices() []*CategoryPrice {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *ConfigureCinemaRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ConfigureCinemaRequest) GetShowTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ShowTime
+	}
+	return nil
+}
+
+func (x *ConfigureCinemaRequest) GetAccessibleRelease() *durationpb.Duration {
+	if x != nil {
+		return x.AccessibleRelease
+	}
+	return nil
+}
+
+func (x *ConfigureCinemaRequest) GetUnsellableSeats() []*Seat {
+	if x != nil {
+		return x.UnsellableSeats
+	}
+	return nil
+}
+
+func (x *ConfigureCinemaRequest) GetLabels() *LabelScheme {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ConfigureCinemaRequest) GetAdjacency() *AdjacencyPolicy {
+	if x != nil {
+		return x.Adjacency
+	}
+	return nil
+}
+
+func (x *ConfigureCinemaRequest) GetSeparation() *SeparationProfile {
+	if x != nil {
+		return x.Separation
+	}
+	return nil
+}
+
+func (x *ConfigureCinemaRequest) GetPolicies() []*DistancingPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+func (x *ConfigureCinemaRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *ConfigureCinemaRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConfigureCinemaRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type UpdateCinemaConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rows              int32                  `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`                                  // Number of rows in the cinema, 1 to 500
+	Columns           int32                  `protobuf:"varint,2,opt,name=columns,proto3" json:"columns,omitempty"`                            // Number of columns in the cinema, 1 to 500
+	MinDistance       int32                  `protobuf:"varint,3,opt,name=min_distance,json=minDistance,proto3" json:"min_distance,omitempty"` // Minimum Manhattan distance between groups
+	Id                string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Categories        []*CategoryAssignment  `protobuf:"bytes,5,rep,name=categories,proto3" json:"categories,omitempty"`                                        // Replaces seat categories when not empty
+	Prices            []*CategoryPrice       `protobuf:"bytes,6,rep,name=prices,proto3" json:"prices,omitempty"`                                                // Replaces the price table when not empty
+	Currency          string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`                                            // Currency of the price table
+	ShowTime          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=show_time,json=showTime,proto3" json:"show_time,omitempty"`                            // Replaces the start of the show when set
+	AccessibleRelease *durationpb.Duration   `protobuf:"bytes,9,opt,name=accessible_release,json=accessibleRelease,proto3" json:"accessible_release,omitempty"` // Replaces the accessible seats release when set
+	UnsellableSeats   []*Seat                `protobuf:"bytes,10,rep,name=unsellable_seats,json=unsellableSeats,proto3" json:"unsellable_seats,omitempty"`      // Replaces the unsellable seats when not empty
+	Labels            *LabelScheme           `protobuf:"bytes,11,opt,name=labels,proto3" json:"labels,omitempty"`                                               // Replaces the label scheme when set
+	Adjacency         *AdjacencyPolicy       `protobuf:"bytes,12,opt,name=adjacency,proto3" json:"adjacency,omitempty"`                                         // Replaces the adjacency policy when set
+	Separation        *SeparationProfile     `protobuf:"bytes,13,opt,name=separation,proto3" json:"separation,omitempty"`                                       // Replaces the separation profile when set, an empty one brings min_distance back
+	Policies          []*DistancingPolicy    `protobuf:"bytes,14,rep,name=policies,proto3" json:"policies,omitempty"`                                           // Replaces the schedule of distancing policies when not empty
+	ClearPolicies     bool                   `protobuf:"varint,15,opt,name=clear_policies,json=clearPolicies,proto3" json:"clear_policies,omitempty"`           // Removes every scheduled distancing policy
+	Name              string                 `protobuf:"bytes,16,opt,name=name,proto3" json:"name,omitempty"`                                                   // Replaces the name when not empty
+	Description       string                 `protobuf:"bytes,17,opt,name=description,proto3" json:"description,omitempty"`                                     // Replaces the description when not empty
+}
+
+func (x *UpdateCinemaConfigRequest) Reset() {
+	*x = UpdateCinemaConfigRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCinemaConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCinemaConfigRequest) ProtoMessage() {}
+
+func (x *UpdateCinemaConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCinemaConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCinemaConfigRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateCinemaConfigRequest) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *UpdateCinemaConfigRequest) GetColumns() int32 {
+	if x != nil {
+		return x.Columns
+	}
+	return 0
+}
+
+func (x *UpdateCinemaConfigRequest) GetMinDistance() int32 {
+	if x != nil {
+		return x.MinDistance
+	}
+	return 0
+}
+
+func (x *UpdateCinemaConfigRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCinemaConfigRequest) GetCategories() []*CategoryAssignment {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *UpdateCinemaConfigRequest) GetPrices() []*CategoryPrice {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *UpdateCinemaConfigRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *UpdateCinemaConfigRequest) GetShowTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ShowTime
+	}
+	return nil
+}
+
+func (x *UpdateCinemaConfigRequest) GetAccessibleRelease() *durationpb.Duration {
+	if x != nil {
+		return x.AccessibleRelease
+	}
+	return nil
+}
+
+func (x *UpdateCinemaConfigRequest) GetUnsellableSeats() []*Seat {
+	if x != nil {
+		return x.UnsellableSeats
+	}
+	return nil
+}
+
+func (x *UpdateCinemaConfigRequest) GetLabels() *LabelScheme {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *UpdateCinemaConfigRequest) GetAdjacency() *AdjacencyPolicy {
+	if x != nil {
+		return x.Adjacency
+	}
+	return nil
+}
+
+func (x *UpdateCinemaConfigRequest) GetSeparation() *SeparationProfile {
+	if x != nil {
+		return x.Separation
+	}
+	return nil
+}
+
+func (x *UpdateCinemaConfigRequest) GetPolicies() []*DistancingPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+func (x *UpdateCinemaConfigRequest) GetClearPolicies() bool {
+	if x != nil {
+		return x.ClearPolicies
+	}
+	return false
+}
+
+func (x *UpdateCinemaConfigRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCinemaConfigRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ListCinemasRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize     int32   `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`              // 50 by default, at most 500
+	PageToken    string  `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`            // next_page_token of the previous page
+	NameContains string  `protobuf:"bytes,3,opt,name=name_contains,json=nameContains,proto3" json:"name_contains,omitempty"`   // Case insensitive
+	MinSeats     int32   `protobuf:"varint,4,opt,name=min_seats,json=minSeats,proto3" json:"min_seats,omitempty"`              // Sellable seats
+	MaxSeats     int32   `protobuf:"varint,5,opt,name=max_seats,json=maxSeats,proto3" json:"max_seats,omitempty"`              // Sellable seats, no maximum when 0
+	MinOccupancy float64 `protobuf:"fixed64,6,opt,name=min_occupancy,json=minOccupancy,proto3" json:"min_occupancy,omitempty"` // Share of the sellable seats reserved, from 0 to 1
+	MaxOccupancy float64 `protobuf:"fixed64,7,opt,name=max_occupancy,json=maxOccupancy,proto3" json:"max_occupancy,omitempty"` // No maximum when 0
+}
+
+func (x *ListCinemasRequest) Reset() {
+	*x = ListCinemasRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCinemasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCinemasRequest) ProtoMessage() {}
+
+func (x *ListCinemasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCinemasRequest.ProtoReflect.Descriptor instead.
+func (*ListCinemasRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListCinemasRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCinemasRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCinemasRequest) GetNameContains() string {
+	if x != nil {
+		return x.NameContains
+	}
+	return ""
+}
+
+func (x *ListCinemasRequest) GetMinSeats() int32 {
+	if x != nil {
+		return x.MinSeats
+	}
+	return 0
+}
+
+func (x *ListCinemasRequest) GetMaxSeats() int32 {
+	if x != nil {
+		return x.MaxSeats
+	}
+	return 0
+}
+
+func (x *ListCinemasRequest) GetMinOccupancy() float64 {
+	if x != nil {
+		return x.MinOccupancy
+	}
+	return 0
+}
+
+func (x *ListCinemasRequest) GetMaxOccupancy() float64 {
+	if x != nil {
+		return x.MaxOccupancy
+	}
+	return 0
+}
+
+type ListCinemasResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cinemas       []*CinemaSummary `protobuf:"bytes,1,rep,name=cinemas,proto3" json:"cinemas,omitempty"`
+	NextPageToken string           `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+}
+
+func (x *ListCinemasResponse) Reset() {
+	*x = ListCinemasResponse{}
+	mi := &file_cinema_cinema_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCinemasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCinemasResponse) ProtoMessage() {}
+
+func (x *ListCinemasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCinemasResponse.ProtoReflect.Descriptor instead.
+func (*ListCinemasResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListCinemasResponse) GetCinemas() []*CinemaSummary {
+	if x != nil {
+		return x.Cinemas
+	}
+	return nil
+}
+
+func (x *ListCinemasResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CinemaSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string  `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Rows        int32   `protobuf:"varint,4,opt,name=rows,proto3" json:"rows,omitempty"`
+	Columns     int32   `protobuf:"varint,5,opt,name=columns,proto3" json:"columns,omitempty"`
+	Seats       int32   `protobuf:"varint,6,opt,name=seats,proto3" json:"seats,omitempty"` // Sellable seats
+	Reserved    int32   `protobuf:"varint,7,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	Occupancy   float64 `protobuf:"fixed64,8,opt,name=occupancy,proto3" json:"occupancy,omitempty"`
+	LegacyId    string  `protobuf:"bytes,9,opt,name=legacy_id,json=legacyId,proto3" json:"legacy_id,omitempty"` // Numeric id of the previous versions, while the server still issues them
+}
+
+func (x *CinemaSummary) Reset() {
+	*x = CinemaSummary{}
+	mi := &file_cinema_cinema_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CinemaSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CinemaSummary) ProtoMessage() {}
+
+func (x *CinemaSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CinemaSummary.ProtoReflect.Descriptor instead.
+func (*CinemaSummary) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CinemaSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CinemaSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CinemaSummary) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CinemaSummary) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *CinemaSummary) GetColumns() int32 {
+	if x != nil {
+		return x.Columns
+	}
+	return 0
+}
+
+func (x *CinemaSummary) GetSeats() int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *CinemaSummary) GetReserved() int32 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+func (x *CinemaSummary) GetOccupancy() float64 {
+	if x != nil {
+		return x.Occupancy
+	}
+	return 0
+}
+
+func (x *CinemaSummary) GetLegacyId() string {
+	if x != nil {
+		return x.LegacyId
+	}
+	return ""
+}
+
+type DeleteCinemaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Force bool   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"` // Deletes the cinema even with reservations
+}
+
+func (x *DeleteCinemaRequest) Reset() {
+	*x = DeleteCinemaRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCinemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCinemaRequest) ProtoMessage() {}
+
+func (x *DeleteCinemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCinemaRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCinemaRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteCinemaRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteCinemaRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// Message for exporting a cinema, see docs/layout-format.md
+type ExportCinemaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                  string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Format              LayoutFormat `protobuf:"varint,2,opt,name=format,proto3,enum=cinema.LayoutFormat" json:"format,omitempty"`
+	IncludeReservations bool         `protobuf:"varint,3,opt,name=include_reservations,json=includeReservations,proto3" json:"include_reservations,omitempty"`
+}
+
+func (x *ExportCinemaRequest) Reset() {
+	*x = ExportCinemaRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCinemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCinemaRequest) ProtoMessage() {}
+
+func (x *ExportCinemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCinemaRequest.ProtoReflect.Descriptor instead.
+func (*ExportCinemaRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ExportCinemaRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExportCinemaRequest) GetFormat() LayoutFormat {
+	if x != nil {
+		return x.Format
+	}
+	return LayoutFormat_LAYOUT_FORMAT_JSON
+}
+
+func (x *ExportCinemaRequest) GetIncludeReservations() bool {
+	if x != nil {
+		return x.IncludeReservations
+	}
+	return false
+}
+
+type ExportCinemaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ExportCinemaResponse) Reset() {
+	*x = ExportCinemaResponse{}
+	mi := &file_cinema_cinema_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCinemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCinemaResponse) ProtoMessage() {}
+
+func (x *ExportCinemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCinemaResponse.ProtoReflect.Descriptor instead.
+func (*ExportCinemaResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ExportCinemaResponse) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type ImportCinemaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format      LayoutFormat `protobuf:"varint,1,opt,name=format,proto3,enum=cinema.LayoutFormat" json:"format,omitempty"`
+	Data        string       `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	MinDistance int32        `protobuf:"varint,3,opt,name=min_distance,json=minDistance,proto3" json:"min_distance,omitempty"` // Only used by the CSV format which does not hold it
+	VenueId     string       `protobuf:"bytes,4,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`              // Venue the cinema is a hall of, if any
+}
+
+func (x *ImportCinemaRequest) Reset() {
+	*x = ImportCinemaRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCinemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCinemaRequest) ProtoMessage() {}
+
+func (x *ImportCinemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCinemaRequest.ProtoReflect.Descriptor instead.
+func (*ImportCinemaRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ImportCinemaRequest) GetFormat() LayoutFormat {
+	if x != nil {
+		return x.Format
+	}
+	return LayoutFormat_LAYOUT_FORMAT_JSON
+}
+
+func (x *ImportCinemaRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *ImportCinemaRequest) GetMinDistance() int32 {
+	if x != nil {
+		return x.MinDistance
+	}
+	return 0
+}
+
+func (x *ImportCinemaRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+// Version of a cinema, given by number or by time
+type PointInTime struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Point:
+	//	*PointInTime_Version
+	//	*PointInTime_Time
+	Point isPointInTime_Point `protobuf_oneof:"point"`
+}
+
+func (x *PointInTime) Reset() {
+	*x = PointInTime{}
+	mi := &file_cinema_cinema_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PointInTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PointInTime) ProtoMessage() {}
+
+func (x *PointInTime) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PointInTime.ProtoReflect.Descriptor instead.
+func (*PointInTime) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{9}
+}
+
+func (m *PointInTime) GetPoint() isPointInTime_Point {
+	if m != nil {
+		return m.Point
+	}
+	return nil
+}
+
+func (x *PointInTime) GetVersion() int32 {
+	if x, ok := x.GetPoint().(*PointInTime_Version); ok {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PointInTime) GetTime() *timestamppb.Timestamp {
+	if x, ok := x.GetPoint().(*PointInTime_Time); ok {
+		return x.Time
+	}
+	return nil
+}
+
+type isPointInTime_Point interface {
+	isPointInTime_Point()
+}
+
+type PointInTime_Version struct {
+	Version int32 `protobuf:"varint,1,opt,name=version,proto3,oneof"`
+}
+
+type PointInTime_Time struct {
+	Time *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3,oneof"` // Latest version stored at that time
+}
+
+func (*PointInTime_Version) isPointInTime_Point() {}
+
+func (*PointInTime_Time) isPointInTime_Point() {}
+
+type ListCinemaVersionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ListCinemaVersionsRequest) Reset() {
+	*x = ListCinemaVersionsRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCinemaVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCinemaVersionsRequest) ProtoMessage() {}
+
+func (x *ListCinemaVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCinemaVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCinemaVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListCinemaVersionsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListCinemaVersionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Versions []*CinemaVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+}
+
+func (x *ListCinemaVersionsResponse) Reset() {
+	*x = ListCinemaVersionsResponse{}
+	mi := &file_cinema_cinema_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCinemaVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCinemaVersionsResponse) ProtoMessage() {}
+
+func (x *ListCinemaVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCinemaVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCinemaVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListCinemaVersionsResponse) GetVersions() []*CinemaVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type CinemaVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Time    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Event   string                 `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"` // Change creating the version, e.g. CinemaConfigured, SeatsReserved or PartyWaitlisted
+}
+
+func (x *CinemaVersion) Reset() {
+	*x = CinemaVersion{}
+	mi := &file_cinema_cinema_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CinemaVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CinemaVersion) ProtoMessage() {}
+
+func (x *CinemaVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CinemaVersion.ProtoReflect.Descriptor instead.
+func (*CinemaVersion) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CinemaVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CinemaVersion) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *CinemaVersion) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+// Group names are only shown to callers with the "staff" role
+type DiffCinemaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	From *PointInTime `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To   *PointInTime `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"` // The current version by default
+}
+
+func (x *DiffCinemaRequest) Reset() {
+	*x = DiffCinemaRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffCinemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffCinemaRequest) ProtoMessage() {}
+
+func (x *DiffCinemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffCinemaRequest.ProtoReflect.Descriptor instead.
+func (*DiffCinemaRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DiffCinemaRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DiffCinemaRequest) GetFrom() *PointInTime {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *DiffCinemaRequest) GetTo() *PointInTime {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type DiffCinemaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromVersion int32         `protobuf:"varint,1,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion   int32         `protobuf:"varint,2,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	Changes     []*SeatChange `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *DiffCinemaResponse) Reset() {
+	*x = DiffCinemaResponse{}
+	mi := &file_cinema_cinema_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffCinemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffCinemaResponse) ProtoMessage() {}
+
+func (x *DiffCinemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffCinemaResponse.ProtoReflect.Descriptor instead.
+func (*DiffCinemaResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DiffCinemaResponse) GetFromVersion() int32 {
+	if x != nil {
+		return x.FromVersion
+	}
+	return 0
+}
+
+func (x *DiffCinemaResponse) GetToVersion() int32 {
+	if x != nil {
+		return x.ToVersion
+	}
+	return 0
+}
+
+func (x *DiffCinemaResponse) GetChanges() []*SeatChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type SeatChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seat       *Seat      `protobuf:"bytes,1,opt,name=seat,proto3" json:"seat,omitempty"`
+	FromStatus SeatStatus `protobuf:"varint,2,opt,name=from_status,json=fromStatus,proto3,enum=cinema.SeatStatus" json:"from_status,omitempty"` // SEAT_STATUS_AVAILABLE or SEAT_STATUS_RESERVED
+	FromGroup  string     `protobuf:"bytes,3,opt,name=from_group,json=fromGroup,proto3" json:"from_group,omitempty"`
+	ToStatus   SeatStatus `protobuf:"varint,4,opt,name=to_status,json=toStatus,proto3,enum=cinema.SeatStatus" json:"to_status,omitempty"`
+	ToGroup    string     `protobuf:"bytes,5,opt,name=to_group,json=toGroup,proto3" json:"to_group,omitempty"`
+}
+
+func (x *SeatChange) Reset() {
+	*x = SeatChange{}
+	mi := &file_cinema_cinema_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatChange) ProtoMessage() {}
+
+func (x *SeatChange) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatChange.ProtoReflect.Descriptor instead.
+func (*SeatChange) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SeatChange) GetSeat() *Seat {
+	if x != nil {
+		return x.Seat
+	}
+	return nil
+}
+
+func (x *SeatChange) GetFromStatus() SeatStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return SeatStatus_SEAT_STATUS_AVAILABLE
+}
+
+func (x *SeatChange) GetFromGroup() string {
+	if x != nil {
+		return x.FromGroup
+	}
+	return ""
+}
+
+func (x *SeatChange) GetToStatus() SeatStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return SeatStatus_SEAT_STATUS_AVAILABLE
+}
+
+func (x *SeatChange) GetToGroup() string {
+	if x != nil {
+		return x.ToGroup
+	}
+	return ""
+}
+
+type RevertCinemaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	To           *PointInTime `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	DiscardNewer bool         `protobuf:"varint,3,opt,name=discard_newer,json=discardNewer,proto3" json:"discard_newer,omitempty"` // Also cancel the reservations made since
+}
+
+func (x *RevertCinemaRequest) Reset() {
+	*x = RevertCinemaRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertCinemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertCinemaRequest) ProtoMessage() {}
+
+func (x *RevertCinemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertCinemaRequest.ProtoReflect.Descriptor instead.
+func (*RevertCinemaRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RevertCinemaRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RevertCinemaRequest) GetTo() *PointInTime {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *RevertCinemaRequest) GetDiscardNewer() bool {
+	if x != nil {
+		return x.DiscardNewer
+	}
+	return false
+}
+
+type RevertCinemaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version int32         `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"` // Version created by the revert
+	Changes []*SeatChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *RevertCinemaResponse) Reset() {
+	*x = RevertCinemaResponse{}
+	mi := &file_cinema_cinema_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertCinemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertCinemaResponse) ProtoMessage() {}
+
+func (x *RevertCinemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertCinemaResponse.ProtoReflect.Descriptor instead.
+func (*RevertCinemaResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RevertCinemaResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RevertCinemaResponse) GetChanges() []*SeatChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// Message for querying the audit log, empty fields match every event
+type ListAuditEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CinemaId string                 `protobuf:"bytes,1,opt,name=cinema_id,json=cinemaId,proto3" json:"cinema_id,omitempty"`
+	Actor    string                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Since    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"` // Inclusive
+	Until    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=until,proto3" json:"until,omitempty"` // Exclusive
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListAuditEventsRequest) GetCinemaId() string {
+	if x != nil {
+		return x.CinemaId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_cinema_cinema_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type AuditEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Time      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Actor     string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`         // Name of the api key of the caller
+	Operation string                 `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"` // configure, update, import, reserve, cancel, batch_reserve, plan_apply, revert,
+	// waitlist_join, waitlist_leave, hold, hold_confirm or hold_release
+	CinemaId  string  `protobuf:"bytes,5,opt,name=cinema_id,json=cinemaId,proto3" json:"cinema_id,omitempty"`
+	GroupName string  `protobuf:"bytes,6,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	Seats     []*Seat `protobuf:"bytes,7,rep,name=seats,proto3" json:"seats,omitempty"` // Seats as given in the request
+	Success   bool    `protobuf:"varint,8,opt,name=success,proto3" json:"success,omitempty"`
+	Error     string  `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_cinema_cinema_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AuditEvent) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetCinemaId() string {
+	if x != nil {
+		return x.CinemaId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetSeats() []*Seat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AuditEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Place of an organization, its halls are cinemas. Organizations are told by the x-tenant
+// metadata (Grpc-Metadata-X-Tenant header), callers without one belong to "default".
+type Venue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address   string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	HallIds   []string               `protobuf:"bytes,4,rep,name=hall_ids,json=hallIds,proto3" json:"hall_ids,omitempty"` // Ids of the cinemas of the venue
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *Venue) Reset() {
+	*x = Venue{}
+	mi := &file_cinema_cinema_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Venue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Venue) ProtoMessage() {}
+
+func (x *Venue) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Venue.ProtoReflect.Descriptor instead.
+func (*Venue) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Venue) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Venue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Venue) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Venue) GetHallIds() []string {
+	if x != nil {
+		return x.HallIds
+	}
+	return nil
+}
+
+func (x *Venue) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateVenueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *CreateVenueRequest) Reset() {
+	*x = CreateVenueRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVenueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVenueRequest) ProtoMessage() {}
+
+func (x *CreateVenueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVenueRequest.ProtoReflect.Descriptor instead.
+func (*CreateVenueRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateVenueRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateVenueRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type ListVenuesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListVenuesRequest) Reset() {
+	*x = ListVenuesRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVenuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVenuesRequest) ProtoMessage() {}
+
+func (x *ListVenuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVenuesRequest.ProtoReflect.Descriptor instead.
+func (*ListVenuesRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{23}
+}
+
+type ListVenuesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Venues []*Venue `protobuf:"bytes,1,rep,name=venues,proto3" json:"venues,omitempty"`
+}
+
+func (x *ListVenuesResponse) Reset() {
+	*x = ListVenuesResponse{}
+	mi := &file_cinema_cinema_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVenuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVenuesResponse) ProtoMessage() {}
+
+func (x *ListVenuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVenuesResponse.ProtoReflect.Descriptor instead.
+func (*ListVenuesResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListVenuesResponse) GetVenues() []*Venue {
+	if x != nil {
+		return x.Venues
+	}
+	return nil
+}
+
+type DeleteVenueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteVenueRequest) Reset() {
+	*x = DeleteVenueRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVenueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVenueRequest) ProtoMessage() {}
+
+func (x *DeleteVenueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVenueRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVenueRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteVenueRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CreateWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url        string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes []string `protobuf:"bytes,2,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"` // CinemaConfigured, ConfigUpdated, SeatsReserved, SeatsCancelled, SeatsHeld,
+	// HoldConfirmed, HoldReleased or WaitlistNotified, every type when empty
+	Secret   string `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`                     // Key of the HMAC-SHA256 signature in the X-Seat-Signature header
+	CinemaId string `protobuf:"bytes,4,opt,name=cinema_id,json=cinemaId,proto3" json:"cinema_id,omitempty"` // Only events of this cinema when set
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *CreateWebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetCinemaId() string {
+	if x != nil {
+		return x.CinemaId
+	}
+	return ""
+}
+
+// The secret is never returned
+type Webhook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url        string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes []string               `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	CinemaId   string                 `protobuf:"bytes,4,opt,name=cinema_id,json=cinemaId,proto3" json:"cinema_id,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_cinema_cinema_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Webhook) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *Webhook) GetCinemaId() string {
+	if x != nil {
+		return x.CinemaId
+	}
+	return ""
+}
+
+func (x *Webhook) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{28}
+}
+
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Webhooks []*Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_cinema_cinema_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeleteWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WebhookId string         `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`      // Deliveries of every webhook when empty
+	Status    DeliveryStatus `protobuf:"varint,2,opt,name=status,proto3,enum=cinema.DeliveryStatus" json:"status,omitempty"` // Deliveries of every status when unspecified
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetStatus() DeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deliveries []*WebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_cinema_cinema_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+type WebhookDelivery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookId   string                 `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	EventType   string                 `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	CinemaId    string                 `protobuf:"bytes,4,opt,name=cinema_id,json=cinemaId,proto3" json:"cinema_id,omitempty"`
+	Status      DeliveryStatus         `protobuf:"varint,5,opt,name=status,proto3,enum=cinema.DeliveryStatus" json:"status,omitempty"`
+	Attempts    int32                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError   string                 `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	NextAttempt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=next_attempt,json=nextAttempt,proto3" json:"next_attempt,omitempty"` // When a pending delivery is sent again
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Payload     string                 `protobuf:"bytes,11,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_cinema_cinema_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WebhookDelivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetCinemaId() string {
+	if x != nil {
+		return x.CinemaId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() DeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetNextAttempt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttempt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+type RetryWebhookDeliveryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RetryWebhookDeliveryRequest) Reset() {
+	*x = RetryWebhookDeliveryRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryWebhookDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryWebhookDeliveryRequest) ProtoMessage() {}
+
+func (x *RetryWebhookDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryWebhookDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*RetryWebhookDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RetryWebhookDeliveryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Message for querying available seats
+type GetAvailableSeatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AvailableSeats []*Seat `protobuf:"bytes,1,rep,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"` // List of available seats
+	Grid           string  `protobuf:"bytes,2,opt,name=grid,proto3" json:"grid,omitempty"`
+}
+
+func (x *GetAvailableSeatsResponse) Reset() {
+	*x = GetAvailableSeatsResponse{}
+	mi := &file_cinema_cinema_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailableSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailableSeatsResponse) ProtoMessage() {}
+
+func (x *GetAvailableSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailableSeatsResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailableSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetAvailableSeatsResponse) GetAvailableSeats() []*Seat {
+	if x != nil {
+		return x.AvailableSeats
+	}
+	return nil
+}
+
+func (x *GetAvailableSeatsResponse) GetGrid() string {
+	if x != nil {
+		return x.Grid
+	}
+	return ""
+}
+
+type GetAvailableSeatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Categories []SeatCategory `protobuf:"varint,2,rep,packed,name=categories,proto3,enum=cinema.SeatCategory" json:"categories,omitempty"` // Only return seats of these categories, all if empty
+}
+
+func (x *GetAvailableSeatsRequest) Reset() {
+	*x = GetAvailableSeatsRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailableSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailableSeatsRequest) ProtoMessage() {}
+
+func (x *GetAvailableSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailableSeatsRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailableSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetAvailableSeatsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetAvailableSeatsRequest) GetCategories() []SeatCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// Message for querying the seat map. Group names of other groups are only
+// shown to callers with the "staff" role, sent as the x-role metadata.
+type GetSeatMapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupName string       `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"` // Group of the caller, its seats are marked as owned
+	AsOf      *PointInTime `protobuf:"bytes,3,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`                // Past state of the cinema, the current one by default
+}
+
+func (x *GetSeatMapRequest) Reset() {
+	*x = GetSeatMapRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeatMapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeatMapRequest) ProtoMessage() {}
+
+func (x *GetSeatMapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeatMapRequest.ProtoReflect.Descriptor instead.
+func (*GetSeatMapRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetSeatMapRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetSeatMapRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *GetSeatMapRequest) GetAsOf() *PointInTime {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+type GetSeatMapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rows          int32      `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
+	Columns       int32      `protobuf:"varint,2,opt,name=columns,proto3" json:"columns,omitempty"`
+	MinDistance   int32      `protobuf:"varint,3,opt,name=min_distance,json=minDistance,proto3" json:"min_distance,omitempty"`
+	SeatRows      []*SeatRow `protobuf:"bytes,4,rep,name=seat_rows,json=seatRows,proto3" json:"seat_rows,omitempty"`
+	ExclusionZone []string   `protobuf:"bytes,5,rep,name=exclusion_zone,json=exclusionZone,proto3" json:"exclusion_zone,omitempty"` // Seats around a reserved seat "O" other groups cannot take as "X", the first row towards the screen
+}
+
+func (x *GetSeatMapResponse) Reset() {
+	*x = GetSeatMapResponse{}
+	mi := &file_cinema_cinema_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeatMapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeatMapResponse) ProtoMessage() {}
+
+func (x *GetSeatMapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeatMapResponse.ProtoReflect.Descriptor instead.
+func (*GetSeatMapResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetSeatMapResponse) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *GetSeatMapResponse) GetColumns() int32 {
+	if x != nil {
+		return x.Columns
+	}
+	return 0
+}
+
+func (x *GetSeatMapResponse) GetMinDistance() int32 {
+	if x != nil {
+		return x.MinDistance
+	}
+	return 0
+}
+
+func (x *GetSeatMapResponse) GetSeatRows() []*SeatRow {
+	if x != nil {
+		return x.SeatRows
+	}
+	return nil
+}
+
+func (x *GetSeatMapResponse) GetExclusionZone() []string {
+	if x != nil {
+		return x.ExclusionZone
+	}
+	return nil
+}
+
+type SeatRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Row   int32        `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"` // Row index (0-based)
+	Label string       `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Seats []*SeatState `protobuf:"bytes,3,rep,name=seats,proto3" json:"seats,omitempty"`
+}
+
+func (x *SeatRow) Reset() {
+	*x = SeatRow{}
+	mi := &file_cinema_cinema_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatRow) ProtoMessage() {}
+
+func (x *SeatRow) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatRow.ProtoReflect.Descriptor instead.
+func (*SeatRow) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SeatRow) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *SeatRow) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SeatRow) GetSeats() []*SeatState {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+type SeatState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seat      *Seat      `protobuf:"bytes,1,opt,name=seat,proto3" json:"seat,omitempty"`
+	Label     string     `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Status    SeatStatus `protobuf:"varint,3,opt,name=status,proto3,enum=cinema.SeatStatus" json:"status,omitempty"`
+	GroupName string     `protobuf:"bytes,4,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"` // Group holding the seat, if visible to the caller
+	Owned     bool       `protobuf:"varint,5,opt,name=owned,proto3" json:"owned,omitempty"`                         // The seat is held by the caller's group
+}
+
+func (x *SeatState) Reset() {
+	*x = SeatState{}
+	mi := &file_cinema_cinema_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatState) ProtoMessage() {}
+
+func (x *SeatState) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatState.ProtoReflect.Descriptor instead.
+func (*SeatState) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SeatState) GetSeat() *Seat {
+	if x != nil {
+		return x.Seat
+	}
+	return nil
+}
+
+func (x *SeatState) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SeatState) GetStatus() SeatStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SeatStatus_SEAT_STATUS_AVAILABLE
+}
+
+func (x *SeatState) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *SeatState) GetOwned() bool {
+	if x != nil {
+		return x.Owned
+	}
+	return false
+}
+
+// Message for reserving seats
+type ReserveSeatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SeatCoords []*Seat          `protobuf:"bytes,2,rep,name=seat_coords,json=seatCoords,proto3" json:"seat_coords,omitempty"` // Coordinates of seats to reserve
+	GroupName  string           `protobuf:"bytes,3,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	Accessible bool             `protobuf:"varint,4,opt,name=accessible,proto3" json:"accessible,omitempty"` // The group needs wheelchair spaces
+	Adjacency  *AdjacencyPolicy `protobuf:"bytes,5,opt,name=adjacency,proto3" json:"adjacency,omitempty"`    // Overrides the adjacency policy of the cinema, it must be at least as strict
+}
+
+func (x *ReserveSeatsRequest) Reset() {
+	*x = ReserveSeatsRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveSeatsRequest) ProtoMessage() {}
+
+func (x *ReserveSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveSeatsRequest.ProtoReflect.Descriptor instead.
+func (*ReserveSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ReserveSeatsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReserveSeatsRequest) GetSeatCoords() []*Seat {
+	if x != nil {
+		return x.SeatCoords
+	}
+	return nil
+}
+
+func (x *ReserveSeatsRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *ReserveSeatsRequest) GetAccessible() bool {
+	if x != nil {
+		return x.Accessible
+	}
+	return false
+}
+
+func (x *ReserveSeatsRequest) GetAdjacency() *AdjacencyPolicy {
+	if x != nil {
+		return x.Adjacency
+	}
+	return nil
+}
+
+type ReserveSeatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success  bool         `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // Indication if the reservation was successful
+	Items    []*SeatPrice `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`      // Price of every reserved seat
+	Total    int64        `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`     // Sum of the items' price
+	Currency string       `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+}
+
+func (x *ReserveSeatsResponse) Reset() {
+	*x = ReserveSeatsResponse{}
+	mi := &file_cinema_cinema_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveSeatsResponse) ProtoMessage() {}
+
+func (x *ReserveSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveSeatsResponse.ProtoReflect.Descriptor instead.
+func (*ReserveSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ReserveSeatsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReserveSeatsResponse) GetItems() []*SeatPrice {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ReserveSeatsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ReserveSeatsResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type SuccessResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // Indication if the reservation was successful
+}
+
+func (x *SuccessResponse) Reset() {
+	*x = SuccessResponse{}
+	mi := &file_cinema_cinema_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuccessResponse) ProtoMessage() {}
+
+func (x *SuccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuccessResponse.ProtoReflect.Descriptor instead.
+func (*SuccessResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SuccessResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Message for canceling seat reservations
+type CancelSeatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SeatCoords []*Seat `protobuf:"bytes,2,rep,name=seat_coords,json=seatCoords,proto3" json:"seat_coords,omitempty"` // Coordinates of seats to cancel
+}
+
+func (x *CancelSeatsRequest) Reset() {
+	*x = CancelSeatsRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSeatsRequest) ProtoMessage() {}
+
+func (x *CancelSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSeatsRequest.ProtoReflect.Descriptor instead.
+func (*CancelSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CancelSeatsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelSeatsRequest) GetSeatCoords() []*Seat {
+	if x != nil {
+		return x.SeatCoords
+	}
+	return nil
+}
+
+type JoinWaitlistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupName  string               `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"` // Contact of the party
+	Size       int32                `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Accessible bool                 `protobuf:"varint,4,opt,name=accessible,proto3" json:"accessible,omitempty"`             // The party needs wheelchair spaces
+	AutoHold   bool                 `protobuf:"varint,5,opt,name=auto_hold,json=autoHold,proto3" json:"auto_hold,omitempty"` // Hold freed seats for the party instead of notifying it
+	HoldFor    *durationpb.Duration `protobuf:"bytes,6,opt,name=hold_for,json=holdFor,proto3" json:"hold_for,omitempty"`     // How long seats are held, 15 minutes by default
+}
+
+func (x *JoinWaitlistRequest) Reset() {
+	*x = JoinWaitlistRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinWaitlistRequest) ProtoMessage() {}
+
+func (x *JoinWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*JoinWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *JoinWaitlistRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *JoinWaitlistRequest) GetAccessible() bool {
+	if x != nil {
+		return x.Accessible
+	}
+	return false
+}
+
+func (x *JoinWaitlistRequest) GetAutoHold() bool {
+	if x != nil {
+		return x.AutoHold
+	}
+	return false
+}
+
+func (x *JoinWaitlistRequest) GetHoldFor() *durationpb.Duration {
+	if x != nil {
+		return x.HoldFor
+	}
+	return nil
+}
+
+type WaitlistGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupName string `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+}
+
+func (x *WaitlistGroupRequest) Reset() {
+	*x = WaitlistGroupRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitlistGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitlistGroupRequest) ProtoMessage() {}
+
+func (x *WaitlistGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitlistGroupRequest.ProtoReflect.Descriptor instead.
+func (*WaitlistGroupRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *WaitlistGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WaitlistGroupRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+type WaitlistPositionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position int32     `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"` // From 1, 0 when seats are held for the party
+	Waiting  int32     `protobuf:"varint,2,opt,name=waiting,proto3" json:"waiting,omitempty"`   // Number of parties on the waitlist
+	Hold     *SeatHold `protobuf:"bytes,3,opt,name=hold,proto3" json:"hold,omitempty"`
+}
+
+func (x *WaitlistPositionResponse) Reset() {
+	*x = WaitlistPositionResponse{}
+	mi := &file_cinema_cinema_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitlistPositionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitlistPositionResponse) ProtoMessage() {}
+
+func (x *WaitlistPositionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitlistPositionResponse.ProtoReflect.Descriptor instead.
+func (*WaitlistPositionResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *WaitlistPositionResponse) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *WaitlistPositionResponse) GetWaiting() int32 {
+	if x != nil {
+		return x.Waiting
+	}
+	return 0
+}
+
+func (x *WaitlistPositionResponse) GetHold() *SeatHold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+type GetWaitlistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetWaitlistRequest) Reset() {
+	*x = GetWaitlistRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWaitlistRequest) ProtoMessage() {}
+
+func (x *GetWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*GetWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetWaitlistRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Group names are only shown to callers with the "staff" role
+type GetWaitlistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*WaitlistEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"` // In waitlist order
+	Holds   []*SeatHold      `protobuf:"bytes,2,rep,name=holds,proto3" json:"holds,omitempty"`
+}
+
+func (x *GetWaitlistResponse) Reset() {
+	*x = GetWaitlistResponse{}
+	mi := &file_cinema_cinema_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWaitlistResponse) ProtoMessage() {}
+
+func (x *GetWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*GetWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetWaitlistResponse) GetEntries() []*WaitlistEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetWaitlistResponse) GetHolds() []*SeatHold {
+	if x != nil {
+		return x.Holds
+	}
+	return nil
+}
+
+type WaitlistEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupName  string                 `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	Size       int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Accessible bool                   `protobuf:"varint,3,opt,name=accessible,proto3" json:"accessible,omitempty"`
+	AutoHold   bool                   `protobuf:"varint,4,opt,name=auto_hold,json=autoHold,proto3" json:"auto_hold,omitempty"`
+	HoldFor    *durationpb.Duration   `protobuf:"bytes,5,opt,name=hold_for,json=holdFor,proto3" json:"hold_for,omitempty"`
+	JoinedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+}
+
+func (x *WaitlistEntry) Reset() {
+	*x = WaitlistEntry{}
+	mi := &file_cinema_cinema_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitlistEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitlistEntry) ProtoMessage() {}
+
+func (x *WaitlistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitlistEntry.ProtoReflect.Descriptor instead.
+func (*WaitlistEntry) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *WaitlistEntry) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetAccessible() bool {
+	if x != nil {
+		return x.Accessible
+	}
+	return false
+}
+
+func (x *WaitlistEntry) GetAutoHold() bool {
+	if x != nil {
+		return x.AutoHold
+	}
+	return false
+}
+
+func (x *WaitlistEntry) GetHoldFor() *durationpb.Duration {
+	if x != nil {
+		return x.HoldFor
+	}
+	return nil
+}
+
+func (x *WaitlistEntry) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+type SeatHold struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupName string                 `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	Seats     []*Seat                `protobuf:"bytes,2,rep,name=seats,proto3" json:"seats,omitempty"`
+	Until     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"` // The seats are released afterwards
+}
+
+func (x *SeatHold) Reset() {
+	*x = SeatHold{}
+	mi := &file_cinema_cinema_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatHold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatHold) ProtoMessage() {}
+
+func (x *SeatHold) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatHold.ProtoReflect.Descriptor instead.
+func (*SeatHold) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SeatHold) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *SeatHold) GetSeats() []*Seat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *SeatHold) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+// Message for reserving seats for several groups atomically
+type BatchReserveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Groups []*GroupReservation `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *BatchReserveRequest) Reset() {
+	*x = BatchReserveRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchReserveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchReserveRequest) ProtoMessage() {}
+
+func (x *BatchReserveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchReserveRequest.ProtoReflect.Descriptor instead.
+func (*BatchReserveRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *BatchReserveRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BatchReserveRequest) GetGroups() []*GroupReservation {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type GroupReservation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupName  string  `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	SeatCoords []*Seat `protobuf:"bytes,2,rep,name=seat_coords,json=seatCoords,proto3" json:"seat_coords,omitempty"`
+	Accessible bool    `protobuf:"varint,3,opt,name=accessible,proto3" json:"accessible,omitempty"` // The group needs wheelchair spaces
+}
+
+func (x *GroupReservation) Reset() {
+	*x = GroupReservation{}
+	mi := &file_cinema_cinema_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupReservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupReservation) ProtoMessage() {}
+
+func (x *GroupReservation) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupReservation.ProtoReflect.Descriptor instead.
+func (*GroupReservation) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GroupReservation) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *GroupReservation) GetSeatCoords() []*Seat {
+	if x != nil {
+		return x.SeatCoords
+	}
+	return nil
+}
+
+func (x *GroupReservation) GetAccessible() bool {
+	if x != nil {
+		return x.Accessible
+	}
+	return false
+}
+
+type BatchReserveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success  bool                      `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // True if every group has been reserved
+	Results  []*GroupReservationResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`  // One result per requested group, in order
+	Currency string                    `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+}
+
+func (x *BatchReserveResponse) Reset() {
+	*x = BatchReserveResponse{}
+	mi := &file_cinema_cinema_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchReserveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchReserveResponse) ProtoMessage() {}
+
+func (x *BatchReserveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchReserveResponse.ProtoReflect.Descriptor instead.
+func (*BatchReserveResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *BatchReserveResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BatchReserveResponse) GetResults() []*GroupReservationResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchReserveResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type GroupReservationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupName string       `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	Error     string       `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"` // Why the group could not be reserved, empty on success
+	Items     []*SeatPrice `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"` // Price of every seat, set on success
+	Total     int64        `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *GroupReservationResult) Reset() {
+	*x = GroupReservationResult{}
+	mi := &file_cinema_cinema_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupReservationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupReservationResult) ProtoMessage() {}
+
+func (x *GroupReservationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupReservationResult.ProtoReflect.Descriptor instead.
+func (*GroupReservationResult) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GroupReservationResult) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *GroupReservationResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GroupReservationResult) GetItems() []*SeatPrice {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GroupReservationResult) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Message for planning the seats of several parties
+type PlanSeatingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Parties   []*Party             `protobuf:"bytes,2,rep,name=parties,proto3" json:"parties,omitempty"`
+	Objective PlanObjective        `protobuf:"varint,3,opt,name=objective,proto3,enum=cinema.PlanObjective" json:"objective,omitempty"`
+	Exact     bool                 `protobuf:"varint,4,opt,name=exact,proto3" json:"exact,omitempty"`    // Search for the best plan after the greedy one
+	Timeout   *durationpb.Duration `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"` // Bounds the planning, 2s by default
+	Apply     bool                 `protobuf:"varint,6,opt,name=apply,proto3" json:"apply,omitempty"`    // Reserve the planned seats
+}
+
+func (x *PlanSeatingRequest) Reset() {
+	*x = PlanSeatingRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanSeatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanSeatingRequest) ProtoMessage() {}
+
+func (x *PlanSeatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanSeatingRequest.ProtoReflect.Descriptor instead.
+func (*PlanSeatingRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PlanSeatingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PlanSeatingRequest) GetParties() []*Party {
+	if x != nil {
+		return x.Parties
+	}
+	return nil
+}
+
+func (x *PlanSeatingRequest) GetObjective() PlanObjective {
+	if x != nil {
+		return x.Objective
+	}
+	return PlanObjective_PLAN_OBJECTIVE_PEOPLE
+}
+
+func (x *PlanSeatingRequest) GetExact() bool {
+	if x != nil {
+		return x.Exact
+	}
+	return false
+}
+
+func (x *PlanSeatingRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *PlanSeatingRequest) GetApply() bool {
+	if x != nil {
+		return x.Apply
+	}
+	return false
+}
+
+type Party struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupName string `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"` // Unique per request, generated if empty
+	Size      int32  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Priority  int32  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"` // Parties with a higher priority are seated first
+}
+
+func (x *Party) Reset() {
+	*x = Party{}
+	mi := &file_cinema_cinema_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Party) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Party) ProtoMessage() {}
+
+func (x *Party) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Party.ProtoReflect.Descriptor instead.
+func (*Party) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Party) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *Party) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Party) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type PlanSeatingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Assignments   []*PartyAssignment `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	Unseated      []string           `protobuf:"bytes,2,rep,name=unseated,proto3" json:"unseated,omitempty"` // Group names of the parties without seats
+	SeatedPeople  int32              `protobuf:"varint,3,opt,name=seated_people,json=seatedPeople,proto3" json:"seated_people,omitempty"`
+	SeatedParties int32              `protobuf:"varint,4,opt,name=seated_parties,json=seatedParties,proto3" json:"seated_parties,omitempty"`
+	Optimal       bool               `protobuf:"varint,5,opt,name=optimal,proto3" json:"optimal,omitempty"` // The exact search completed before the timeout
+	Applied       bool               `protobuf:"varint,6,opt,name=applied,proto3" json:"applied,omitempty"` // The assignments have been reserved
+}
+
+func (x *PlanSeatingResponse) Reset() {
+	*x = PlanSeatingResponse{}
+	mi := &file_cinema_cinema_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanSeatingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanSeatingResponse) ProtoMessage() {}
+
+func (x *PlanSeatingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanSeatingResponse.ProtoReflect.Descriptor instead.
+func (*PlanSeatingResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *PlanSeatingResponse) GetAssignments() []*PartyAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+func (x *PlanSeatingResponse) GetUnseated() []string {
+	if x != nil {
+		return x.Unseated
+	}
+	return nil
+}
+
+func (x *PlanSeatingResponse) GetSeatedPeople() int32 {
+	if x != nil {
+		return x.SeatedPeople
+	}
+	return 0
+}
+
+func (x *PlanSeatingResponse) GetSeatedParties() int32 {
+	if x != nil {
+		return x.SeatedParties
+	}
+	return 0
+}
+
+func (x *PlanSeatingResponse) GetOptimal() bool {
+	if x != nil {
+		return x.Optimal
+	}
+	return false
+}
+
+func (x *PlanSeatingResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+type PartyAssignment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupName string  `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	Seats     []*Seat `protobuf:"bytes,2,rep,name=seats,proto3" json:"seats,omitempty"`
+}
+
+func (x *PartyAssignment) Reset() {
+	*x = PartyAssignment{}
+	mi := &file_cinema_cinema_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyAssignment) ProtoMessage() {}
+
+func (x *PartyAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyAssignment.ProtoReflect.Descriptor instead.
+func (*PartyAssignment) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *PartyAssignment) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *PartyAssignment) GetSeats() []*Seat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+// Message for analyzing the capacity of a cinema
+type AnalyzeCapacityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MinDistances []int32 `protobuf:"varint,2,rep,packed,name=min_distances,json=minDistances,proto3" json:"min_distances,omitempty"` // Distances to analyze, the configured one if empty
+	GroupSizes   []int32 `protobuf:"varint,3,rep,packed,name=group_sizes,json=groupSizes,proto3" json:"group_sizes,omitempty"`       // Group sizes to count the groups of
+}
+
+func (x *AnalyzeCapacityRequest) Reset() {
+	*x = AnalyzeCapacityRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeCapacityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeCapacityRequest) ProtoMessage() {}
+
+func (x *AnalyzeCapacityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeCapacityRequest.ProtoReflect.Descriptor instead.
+func (*AnalyzeCapacityRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *AnalyzeCapacityRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AnalyzeCapacityRequest) GetMinDistances() []int32 {
+	if x != nil {
+		return x.MinDistances
+	}
+	return nil
+}
+
+func (x *AnalyzeCapacityRequest) GetGroupSizes() []int32 {
+	if x != nil {
+		return x.GroupSizes
+	}
+	return nil
+}
+
+type AnalyzeCapacityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalSeats    int32               `protobuf:"varint,1,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
+	ReservedSeats int32               `protobuf:"varint,2,opt,name=reserved_seats,json=reservedSeats,proto3" json:"reserved_seats,omitempty"`
+	Occupancy     float64             `protobuf:"fixed64,3,opt,name=occupancy,proto3" json:"occupancy,omitempty"` // Reserved seats over total seats
+	Scenarios     []*CapacityScenario `protobuf:"bytes,4,rep,name=scenarios,proto3" json:"scenarios,omitempty"`   // One scenario per analyzed distance
+}
+
+func (x *AnalyzeCapacityResponse) Reset() {
+	*x = AnalyzeCapacityResponse{}
+	mi := &file_cinema_cinema_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeCapacityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeCapacityResponse) ProtoMessage() {}
+
+func (x *AnalyzeCapacityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeCapacityResponse.ProtoReflect.Descriptor instead.
+func (*AnalyzeCapacityResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *AnalyzeCapacityResponse) GetTotalSeats() int32 {
+	if x != nil {
+		return x.TotalSeats
+	}
+	return 0
+}
+
+func (x *AnalyzeCapacityResponse) GetReservedSeats() int32 {
+	if x != nil {
+		return x.ReservedSeats
+	}
+	return 0
+}
+
+func (x *AnalyzeCapacityResponse) GetOccupancy() float64 {
+	if x != nil {
+		return x.Occupancy
+	}
+	return 0
+}
+
+func (x *AnalyzeCapacityResponse) GetScenarios() []*CapacityScenario {
+	if x != nil {
+		return x.Scenarios
+	}
+	return nil
+}
+
+type CapacityScenario struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinDistance    int32            `protobuf:"varint,1,opt,name=min_distance,json=minDistance,proto3" json:"min_distance,omitempty"`
+	MaxSeats       int32            `protobuf:"varint,2,opt,name=max_seats,json=maxSeats,proto3" json:"max_seats,omitempty"`                   // Single seats that fit in the empty cinema
+	RemainingSeats int32            `protobuf:"varint,3,opt,name=remaining_seats,json=remainingSeats,proto3" json:"remaining_seats,omitempty"` // Single seats that still fit next to the current reservations
+	DeadSeats      int32            `protobuf:"varint,4,opt,name=dead_seats,json=deadSeats,proto3" json:"dead_seats,omitempty"`                // Free seats no new group can reserve because of the current reservations
+	Groups         []*GroupCapacity `protobuf:"bytes,5,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *CapacityScenario) Reset() {
+	*x = CapacityScenario{}
+	mi := &file_cinema_cinema_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CapacityScenario) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapacityScenario) ProtoMessage() {}
+
+func (x *CapacityScenario) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapacityScenario.ProtoReflect.Descriptor instead.
+func (*CapacityScenario) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CapacityScenario) GetMinDistance() int32 {
+	if x != nil {
+		return x.MinDistance
+	}
+	return 0
+}
+
+func (x *CapacityScenario) GetMaxSeats() int32 {
+	if x != nil {
+		return x.MaxSeats
+	}
+	return 0
+}
+
+func (x *CapacityScenario) GetRemainingSeats() int32 {
+	if x != nil {
+		return x.RemainingSeats
+	}
+	return 0
+}
+
+func (x *CapacityScenario) GetDeadSeats() int32 {
+	if x != nil {
+		return x.DeadSeats
+	}
+	return 0
+}
+
+func (x *CapacityScenario) GetGroups() []*GroupCapacity {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type GroupCapacity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupSize       int32 `protobuf:"varint,1,opt,name=group_size,json=groupSize,proto3" json:"group_size,omitempty"`
+	MaxGroups       int32 `protobuf:"varint,2,opt,name=max_groups,json=maxGroups,proto3" json:"max_groups,omitempty"`                   // Groups that fit in the empty cinema
+	RemainingGroups int32 `protobuf:"varint,3,opt,name=remaining_groups,json=remainingGroups,proto3" json:"remaining_groups,omitempty"` // Groups that still fit next to the current reservations
+}
+
+func (x *GroupCapacity) Reset() {
+	*x = GroupCapacity{}
+	mi := &file_cinema_cinema_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupCapacity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupCapacity) ProtoMessage() {}
+
+func (x *GroupCapacity) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupCapacity.ProtoReflect.Descriptor instead.
+func (*GroupCapacity) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GroupCapacity) GetGroupSize() int32 {
+	if x != nil {
+		return x.GroupSize
+	}
+	return 0
+}
+
+func (x *GroupCapacity) GetMaxGroups() int32 {
+	if x != nil {
+		return x.MaxGroups
+	}
+	return 0
+}
+
+func (x *GroupCapacity) GetRemainingGroups() int32 {
+	if x != nil {
+		return x.RemainingGroups
+	}
+	return 0
+}
+
+// Message for suggesting seats to a group
+type SuggestSeatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupSize  int32  `protobuf:"varint,2,opt,name=group_size,json=groupSize,proto3" json:"group_size,omitempty"`
+	GroupName  string `protobuf:"bytes,3,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	Accessible bool   `protobuf:"varint,4,opt,name=accessible,proto3" json:"accessible,omitempty"` // Prefer wheelchair spaces with their companion seats
+}
+
+func (x *SuggestSeatsRequest) Reset() {
+	*x = SuggestSeatsRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestSeatsRequest) ProtoMessage() {}
+
+func (x *SuggestSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestSeatsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SuggestSeatsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SuggestSeatsRequest) GetGroupSize() int32 {
+	if x != nil {
+		return x.GroupSize
+	}
+	return 0
+}
+
+func (x *SuggestSeatsRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *SuggestSeatsRequest) GetAccessible() bool {
+	if x != nil {
+		return x.Accessible
+	}
+	return false
+}
+
+type SuggestSeatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seats []*Seat `protobuf:"bytes,1,rep,name=seats,proto3" json:"seats,omitempty"` // Empty if no seats can be suggested
+}
+
+func (x *SuggestSeatsResponse) Reset() {
+	*x = SuggestSeatsResponse{}
+	mi := &file_cinema_cinema_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestSeatsResponse) ProtoMessage() {}
+
+func (x *SuggestSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestSeatsResponse.ProtoReflect.Descriptor instead.
+func (*SuggestSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SuggestSeatsResponse) GetSeats() []*Seat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+type ConfigureCinemaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ConfigureCinemaResponse) Reset() {
+	*x = ConfigureCinemaResponse{}
+	mi := &file_cinema_cinema_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigureCinemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureCinemaResponse) ProtoMessage() {}
+
+func (x *ConfigureCinemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureCinemaResponse.ProtoReflect.Descriptor instead.
+func (*ConfigureCinemaResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ConfigureCinemaResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Represents a seat by its row and column coordinates
+type Seat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Row      int32        `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`                                    // Row index (0-based)
+	Column   int32        `protobuf:"varint,2,opt,name=column,proto3" json:"column,omitempty"`                              // Column index (0-based)
+	Category SeatCategory `protobuf:"varint,3,opt,name=category,proto3,enum=cinema.SeatCategory" json:"category,omitempty"` // Category of the seat, ignored in requests
+	Label    string       `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`                                 // Label of the seat, e.g. "F12", replaces row and column in requests when set
+}
+
+func (x *Seat) Reset() {
+	*x = Seat{}
+	mi := &file_cinema_cinema_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Seat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Seat) ProtoMessage() {}
+
+func (x *Seat) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Seat.ProtoReflect.Descriptor instead.
+func (*Seat) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Seat) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *Seat) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *Seat) GetCategory() SeatCategory {
+	if x != nil {
+		return x.Category
+	}
+	return SeatCategory_SEAT_CATEGORY_STANDARD
+}
+
+func (x *Seat) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+// Describes how rows and seats are labeled
+// Minimums per direction replacing the Manhattan min_distance, seats of other groups may not be
+// within horizontal seats in the same row, within vertical rows in the same column, nor within
+// diagonal rows and seats elsewhere
+type SeparationProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Horizontal int32    `protobuf:"varint,1,opt,name=horizontal,proto3" json:"horizontal,omitempty"`
+	Vertical   int32    `protobuf:"varint,2,opt,name=vertical,proto3" json:"vertical,omitempty"`
+	Diagonal   int32    `protobuf:"varint,3,opt,name=diagonal,proto3" json:"diagonal,omitempty"`
+	Mask       []string `protobuf:"bytes,4,rep,name=mask,proto3" json:"mask,omitempty"` // Custom exclusion zone added to the minimums: rows of "." and "X" centered on the seat "O", e.g. [".X.", "XOX", ".X."]
+}
+
+func (x *SeparationProfile) Reset() {
+	*x = SeparationProfile{}
+	mi := &file_cinema_cinema_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeparationProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeparationProfile) ProtoMessage() {}
+
+func (x *SeparationProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeparationProfile.ProtoReflect.Descriptor instead.
+func (*SeparationProfile) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SeparationProfile) GetHorizontal() int32 {
+	if x != nil {
+		return x.Horizontal
+	}
+	return 0
+}
+
+func (x *SeparationProfile) GetVertical() int32 {
+	if x != nil {
+		return x.Vertical
+	}
+	return 0
+}
+
+func (x *SeparationProfile) GetDiagonal() int32 {
+	if x != nil {
+		return x.Diagonal
+	}
+	return 0
+}
+
+func (x *SeparationProfile) GetMask() []string {
+	if x != nil {
+		return x.Mask
+	}
+	return nil
+}
+
+type ExplainSeatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Seat      *Seat  `protobuf:"bytes,2,opt,name=seat,proto3" json:"seat,omitempty"`                            // By row and column or by label
+	GroupName string `protobuf:"bytes,3,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"` // Group which would reserve the seat
+}
+
+func (x *ExplainSeatRequest) Reset() {
+	*x = ExplainSeatRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExplainSeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainSeatRequest) ProtoMessage() {}
+
+func (x *ExplainSeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainSeatRequest.ProtoReflect.Descriptor instead.
+func (*ExplainSeatRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ExplainSeatRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExplainSeatRequest) GetSeat() *Seat {
+	if x != nil {
+		return x.Seat
+	}
+	return nil
+}
+
+func (x *ExplainSeatRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+type ExplainSeatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seat       *Seat           `protobuf:"bytes,1,opt,name=seat,proto3" json:"seat,omitempty"`
+	Reservable bool            `protobuf:"varint,2,opt,name=reservable,proto3" json:"reservable,omitempty"`
+	Conflicts  []*SeatConflict `protobuf:"bytes,3,rep,name=conflicts,proto3" json:"conflicts,omitempty"` // Empty when the seat is reservable
+}
+
+func (x *ExplainSeatResponse) Reset() {
+	*x = ExplainSeatResponse{}
+	mi := &file_cinema_cinema_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExplainSeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainSeatResponse) ProtoMessage() {}
+
+func (x *ExplainSeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainSeatResponse.ProtoReflect.Descriptor instead.
+func (*ExplainSeatResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ExplainSeatResponse) GetSeat() *Seat {
+	if x != nil {
+		return x.Seat
+	}
+	return nil
+}
+
+func (x *ExplainSeatResponse) GetReservable() bool {
+	if x != nil {
+		return x.Reservable
+	}
+	return false
+}
+
+func (x *ExplainSeatResponse) GetConflicts() []*SeatConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+// Reason why a seat cannot be reserved
+type SeatConflict struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason    ConflictReason `protobuf:"varint,1,opt,name=reason,proto3,enum=cinema.ConflictReason" json:"reason,omitempty"`
+	GroupName string         `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"` // Group of the seat reserved or too close, only revealed to staff and to the group itself
+	OtherSeat *Seat          `protobuf:"bytes,3,opt,name=other_seat,json=otherSeat,proto3" json:"other_seat,omitempty"` // Reserved seat too close
+	Distance  int32          `protobuf:"varint,4,opt,name=distance,proto3" json:"distance,omitempty"`                   // Manhattan distance to the reserved seat too close
+}
+
+func (x *SeatConflict) Reset() {
+	*x = SeatConflict{}
+	mi := &file_cinema_cinema_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatConflict) ProtoMessage() {}
+
+func (x *SeatConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatConflict.ProtoReflect.Descriptor instead.
+func (*SeatConflict) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SeatConflict) GetReason() ConflictReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ConflictReason_CONFLICT_REASON_UNSPECIFIED
+}
+
+func (x *SeatConflict) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *SeatConflict) GetOtherSeat() *Seat {
+	if x != nil {
+		return x.OtherSeat
+	}
+	return nil
+}
+
+func (x *SeatConflict) GetDistance() int32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+// Distancing rule applied to new reservations from its effective time, existing reservations are kept
+type DistancingPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	MinDistance   int32                  `protobuf:"varint,2,opt,name=min_distance,json=minDistance,proto3" json:"min_distance,omitempty"`
+	Separation    *SeparationProfile     `protobuf:"bytes,3,opt,name=separation,proto3" json:"separation,omitempty"` // Replaces min_distance when set
+}
+
+func (x *DistancingPolicy) Reset() {
+	*x = DistancingPolicy{}
+	mi := &file_cinema_cinema_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DistancingPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DistancingPolicy) ProtoMessage() {}
+
+func (x *DistancingPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DistancingPolicy.ProtoReflect.Descriptor instead.
+func (*DistancingPolicy) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DistancingPolicy) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *DistancingPolicy) GetMinDistance() int32 {
+	if x != nil {
+		return x.MinDistance
+	}
+	return 0
+}
+
+func (x *DistancingPolicy) GetSeparation() *SeparationProfile {
+	if x != nil {
+		return x.Separation
+	}
+	return nil
+}
+
+type ListDistancingPoliciesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ListDistancingPoliciesRequest) Reset() {
+	*x = ListDistancingPoliciesRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDistancingPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDistancingPoliciesRequest) ProtoMessage() {}
+
+func (x *ListDistancingPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDistancingPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*ListDistancingPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListDistancingPoliciesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListDistancingPoliciesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policies []*DistancingPolicy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"` // Sorted by effective time
+	Current  *DistancingPolicy   `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`   // Policy in effect, unset before the first one
 }
 
-func (x *ConfigureCinemaRequest) Reset() {
-	*x = ConfigureCinemaRequest{}
-	mi := &file_cinema_cinema_proto_msgTypes[0]
+func (x *ListDistancingPoliciesResponse) Reset() {
+	*x = ListDistancingPoliciesResponse{}
+	mi := &file_cinema_cinema_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConfigureCinemaRequest) String() string {
+func (x *ListDistancingPoliciesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigureCinemaRequest) ProtoMessage() {}
+func (*ListDistancingPoliciesResponse) ProtoMessage() {}
 
-func (x *ConfigureCinemaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_cinema_proto_msgTypes[0]
+func (x *ListDistancingPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -59,58 +5386,49 @@ func (x *ConfigureCinemaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigureCinemaRequest.ProtoReflect.Descriptor instead.
-func (*ConfigureCinemaRequest) Descriptor() ([]byte, []int) {
-	return file_cinema_cinema_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *ConfigureCinemaRequest) GetRows() int32 {
-	if x != nil {
-		return x.Rows
-	}
-	return 0
+// Deprecated: Use ListDistancingPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListDistancingPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *ConfigureCinemaRequest) GetColumns() int32 {
+func (x *ListDistancingPoliciesResponse) GetPolicies() []*DistancingPolicy {
 	if x != nil {
-		return x.Columns
+		return x.Policies
 	}
-	return 0
+	return nil
 }
 
-func (x *ConfigureCinemaRequest) GetMinDistance() int32 {
+func (x *ListDistancingPoliciesResponse) GetCurrent() *DistancingPolicy {
 	if x != nil {
-		return x.MinDistance
+		return x.Current
 	}
-	return 0
+	return nil
 }
 
-type UpdateCinemaConfigRequest struct {
+type GetPolicyViolationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rows        int32  `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`                                  // Number of rows in the cinema
-	Columns     int32  `protobuf:"varint,2,opt,name=columns,proto3" json:"columns,omitempty"`                            // Number of columns in the cinema
-	MinDistance int32  `protobuf:"varint,3,opt,name=min_distance,json=minDistance,proto3" json:"min_distance,omitempty"` // Minimum Manhattan distance between groups
-	Id          string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Id string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	At *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"` // Checks the policy in effect at this time, the next scheduled one by default
 }
 
-func (x *UpdateCinemaConfigRequest) Reset() {
-	*x = UpdateCinemaConfigRequest{}
-	mi := &file_cinema_cinema_proto_msgTypes[1]
+func (x *GetPolicyViolationsRequest) Reset() {
+	*x = GetPolicyViolationsRequest{}
+	mi := &file_cinema_cinema_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCinemaConfigRequest) String() string {
+func (x *GetPolicyViolationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCinemaConfigRequest) ProtoMessage() {}
+func (*GetPolicyViolationsRequest) ProtoMessage() {}
 
-func (x *UpdateCinemaConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_cinema_proto_msgTypes[1]
+func (x *GetPolicyViolationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -121,64 +5439,104 @@ func (x *UpdateCinemaConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCinemaConfigRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCinemaConfigRequest) Descriptor() ([]byte, []int) {
-	return file_cinema_cinema_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use GetPolicyViolationsRequest.ProtoReflect.Descriptor instead.
+func (*GetPolicyViolationsRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *UpdateCinemaConfigRequest) GetRows() int32 {
+func (x *GetPolicyViolationsRequest) GetId() string {
 	if x != nil {
-		return x.Rows
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateCinemaConfigRequest) GetColumns() int32 {
+func (x *GetPolicyViolationsRequest) GetAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Columns
+		return x.At
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateCinemaConfigRequest) GetMinDistance() int32 {
+type GetPolicyViolationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy     *DistancingPolicy  `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	Violations []*PolicyViolation `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"`
+}
+
+func (x *GetPolicyViolationsResponse) Reset() {
+	*x = GetPolicyViolationsResponse{}
+	mi := &file_cinema_cinema_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPolicyViolationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPolicyViolationsResponse) ProtoMessage() {}
+
+func (x *GetPolicyViolationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[76]
 	if x != nil {
-		return x.MinDistance
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateCinemaConfigRequest) GetId() string {
+// Deprecated: Use GetPolicyViolationsResponse.ProtoReflect.Descriptor instead.
+func (*GetPolicyViolationsResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetPolicyViolationsResponse) GetPolicy() *DistancingPolicy {
 	if x != nil {
-		return x.Id
+		return x.Policy
 	}
-	return ""
+	return nil
 }
 
-// Message for querying available seats
-type GetAvailableSeatsResponse struct {
+func (x *GetPolicyViolationsResponse) GetViolations() []*PolicyViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+// Reservation of a group too close to other groups under a policy
+type PolicyViolation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AvailableSeats []*Seat `protobuf:"bytes,1,rep,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"` // List of available seats
-	Grid           string  `protobuf:"bytes,2,opt,name=grid,proto3" json:"grid,omitempty"`
+	GroupName  string   `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`      // Only revealed to staff
+	Seats      []*Seat  `protobuf:"bytes,2,rep,name=seats,proto3" json:"seats,omitempty"`                               // Seats of the group too close to other groups
+	TooCloseTo []string `protobuf:"bytes,3,rep,name=too_close_to,json=tooCloseTo,proto3" json:"too_close_to,omitempty"` // Only revealed to staff
 }
 
-func (x *GetAvailableSeatsResponse) Reset() {
-	*x = GetAvailableSeatsResponse{}
-	mi := &file_cinema_cinema_proto_msgTypes[2]
+func (x *PolicyViolation) Reset() {
+	*x = PolicyViolation{}
+	mi := &file_cinema_cinema_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAvailableSeatsResponse) String() string {
+func (x *PolicyViolation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAvailableSeatsResponse) ProtoMessage() {}
+func (*PolicyViolation) ProtoMessage() {}
 
-func (x *GetAvailableSeatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_cinema_proto_msgTypes[2]
+func (x *PolicyViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -189,48 +5547,56 @@ func (x *GetAvailableSeatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAvailableSeatsResponse.ProtoReflect.Descriptor instead.
-func (*GetAvailableSeatsResponse) Descriptor() ([]byte, []int) {
-	return file_cinema_cinema_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use PolicyViolation.ProtoReflect.Descriptor instead.
+func (*PolicyViolation) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *GetAvailableSeatsResponse) GetAvailableSeats() []*Seat {
+func (x *PolicyViolation) GetGroupName() string {
 	if x != nil {
-		return x.AvailableSeats
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *PolicyViolation) GetSeats() []*Seat {
+	if x != nil {
+		return x.Seats
 	}
 	return nil
 }
 
-func (x *GetAvailableSeatsResponse) GetGrid() string {
+func (x *PolicyViolation) GetTooCloseTo() []string {
 	if x != nil {
-		return x.Grid
+		return x.TooCloseTo
 	}
-	return ""
+	return nil
 }
 
-type GetAvailableSeatsRequest struct {
+type AdjacencyPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Rule       Adjacency `protobuf:"varint,1,opt,name=rule,proto3,enum=cinema.Adjacency" json:"rule,omitempty"`
+	AllowSplit bool      `protobuf:"varint,2,opt,name=allow_split,json=allowSplit,proto3" json:"allow_split,omitempty"` // Seats may be apart when no block following the rule is free
 }
 
-func (x *GetAvailableSeatsRequest) Reset() {
-	*x = GetAvailableSeatsRequest{}
-	mi := &file_cinema_cinema_proto_msgTypes[3]
+func (x *AdjacencyPolicy) Reset() {
+	*x = AdjacencyPolicy{}
+	mi := &file_cinema_cinema_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAvailableSeatsRequest) String() string {
+func (x *AdjacencyPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAvailableSeatsRequest) ProtoMessage() {}
+func (*AdjacencyPolicy) ProtoMessage() {}
 
-func (x *GetAvailableSeatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_cinema_proto_msgTypes[3]
+func (x *AdjacencyPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -241,44 +5607,50 @@ func (x *GetAvailableSeatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAvailableSeatsRequest.ProtoReflect.Descriptor instead.
-func (*GetAvailableSeatsRequest) Descriptor() ([]byte, []int) {
-	return file_cinema_cinema_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use AdjacencyPolicy.ProtoReflect.Descriptor instead.
+func (*AdjacencyPolicy) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *GetAvailableSeatsRequest) GetId() string {
+func (x *AdjacencyPolicy) GetRule() Adjacency {
 	if x != nil {
-		return x.Id
+		return x.Rule
 	}
-	return ""
+	return Adjacency_ADJACENCY_ANY
 }
 
-// Message for reserving seats
-type ReserveSeatsRequest struct {
+func (x *AdjacencyPolicy) GetAllowSplit() bool {
+	if x != nil {
+		return x.AllowSplit
+	}
+	return false
+}
+
+type LabelScheme struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	SeatCoords []*Seat `protobuf:"bytes,2,rep,name=seat_coords,json=seatCoords,proto3" json:"seat_coords,omitempty"` // Coordinates of seats to reserve
-	GroupName  string  `protobuf:"bytes,3,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	RowStyle    RowLabelStyle `protobuf:"varint,1,opt,name=row_style,json=rowStyle,proto3,enum=cinema.RowLabelStyle" json:"row_style,omitempty"`
+	SkipLetters string        `protobuf:"bytes,2,opt,name=skip_letters,json=skipLetters,proto3" json:"skip_letters,omitempty"` // Letters not used for rows, e.g. "IO"
+	Numbering   SeatNumbering `protobuf:"varint,3,opt,name=numbering,proto3,enum=cinema.SeatNumbering" json:"numbering,omitempty"`
 }
 
-func (x *ReserveSeatsRequest) Reset() {
-	*x = ReserveSeatsRequest{}
-	mi := &file_cinema_cinema_proto_msgTypes[4]
+func (x *LabelScheme) Reset() {
+	*x = LabelScheme{}
+	mi := &file_cinema_cinema_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReserveSeatsRequest) String() string {
+func (x *LabelScheme) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReserveSeatsRequest) ProtoMessage() {}
+func (*LabelScheme) ProtoMessage() {}
 
-func (x *ReserveSeatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_cinema_proto_msgTypes[4]
+func (x *LabelScheme) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -289,55 +5661,57 @@ func (x *ReserveSeatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReserveSeatsRequest.ProtoReflect.Descriptor instead.
-func (*ReserveSeatsRequest) Descriptor() ([]byte, []int) {
-	return file_cinema_cinema_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use LabelScheme.ProtoReflect.Descriptor instead.
+func (*LabelScheme) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *ReserveSeatsRequest) GetId() string {
+func (x *LabelScheme) GetRowStyle() RowLabelStyle {
 	if x != nil {
-		return x.Id
+		return x.RowStyle
 	}
-	return ""
+	return RowLabelStyle_ROW_LABEL_STYLE_LETTERS
 }
 
-func (x *ReserveSeatsRequest) GetSeatCoords() []*Seat {
+func (x *LabelScheme) GetSkipLetters() string {
 	if x != nil {
-		return x.SeatCoords
+		return x.SkipLetters
 	}
-	return nil
+	return ""
 }
 
-func (x *ReserveSeatsRequest) GetGroupName() string {
+func (x *LabelScheme) GetNumbering() SeatNumbering {
 	if x != nil {
-		return x.GroupName
+		return x.Numbering
 	}
-	return ""
+	return SeatNumbering_SEAT_NUMBERING_LEFT_TO_RIGHT
 }
 
-type SuccessResponse struct {
+// Rectangle of seats from its top left corner to its bottom right one, both included
+type SeatRegion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // Indication if the reservation was successful
+	From *Seat `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   *Seat `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
 }
 
-func (x *SuccessResponse) Reset() {
-	*x = SuccessResponse{}
-	mi := &file_cinema_cinema_proto_msgTypes[5]
+func (x *SeatRegion) Reset() {
+	*x = SeatRegion{}
+	mi := &file_cinema_cinema_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuccessResponse) String() string {
+func (x *SeatRegion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuccessResponse) ProtoMessage() {}
+func (*SeatRegion) ProtoMessage() {}
 
-func (x *SuccessResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_cinema_proto_msgTypes[5]
+func (x *SeatRegion) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -348,43 +5722,51 @@ func (x *SuccessResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuccessResponse.ProtoReflect.Descriptor instead.
-func (*SuccessResponse) Descriptor() ([]byte, []int) {
-	return file_cinema_cinema_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use SeatRegion.ProtoReflect.Descriptor instead.
+func (*SeatRegion) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *SuccessResponse) GetSuccess() bool {
+func (x *SeatRegion) GetFrom() *Seat {
 	if x != nil {
-		return x.Success
+		return x.From
 	}
-	return false
+	return nil
 }
 
-// Message for canceling seat reservations
-type CancelSeatsRequest struct {
+func (x *SeatRegion) GetTo() *Seat {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+// Assigns a category to individual seats and/or regions of seats
+type CategoryAssignment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	SeatCoords []*Seat `protobuf:"bytes,2,rep,name=seat_coords,json=seatCoords,proto3" json:"seat_coords,omitempty"` // Coordinates of seats to cancel
+	Category SeatCategory  `protobuf:"varint,1,opt,name=category,proto3,enum=cinema.SeatCategory" json:"category,omitempty"`
+	Seats    []*Seat       `protobuf:"bytes,2,rep,name=seats,proto3" json:"seats,omitempty"`
+	Regions  []*SeatRegion `protobuf:"bytes,3,rep,name=regions,proto3" json:"regions,omitempty"`
 }
 
-func (x *CancelSeatsRequest) Reset() {
-	*x = CancelSeatsRequest{}
-	mi := &file_cinema_cinema_proto_msgTypes[6]
+func (x *CategoryAssignment) Reset() {
+	*x = CategoryAssignment{}
+	mi := &file_cinema_cinema_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelSeatsRequest) String() string {
+func (x *CategoryAssignment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelSeatsRequest) ProtoMessage() {}
+func (*CategoryAssignment) ProtoMessage() {}
 
-func (x *CancelSeatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_cinema_proto_msgTypes[6]
+func (x *CategoryAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -395,48 +5777,56 @@ func (x *CancelSeatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelSeatsRequest.ProtoReflect.Descriptor instead.
-func (*CancelSeatsRequest) Descriptor() ([]byte, []int) {
-	return file_cinema_cinema_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use CategoryAssignment.ProtoReflect.Descriptor instead.
+func (*CategoryAssignment) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *CancelSeatsRequest) GetId() string {
+func (x *CategoryAssignment) GetCategory() SeatCategory {
 	if x != nil {
-		return x.Id
+		return x.Category
 	}
-	return ""
+	return SeatCategory_SEAT_CATEGORY_STANDARD
 }
 
-func (x *CancelSeatsRequest) GetSeatCoords() []*Seat {
+func (x *CategoryAssignment) GetSeats() []*Seat {
 	if x != nil {
-		return x.SeatCoords
+		return x.Seats
 	}
 	return nil
 }
 
-type ConfigureCinemaResponse struct {
+func (x *CategoryAssignment) GetRegions() []*SeatRegion {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+type CategoryPrice struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Category SeatCategory `protobuf:"varint,1,opt,name=category,proto3,enum=cinema.SeatCategory" json:"category,omitempty"`
+	Price    int64        `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"` // Price in the currency's minor unit
 }
 
-func (x *ConfigureCinemaResponse) Reset() {
-	*x = ConfigureCinemaResponse{}
-	mi := &file_cinema_cinema_proto_msgTypes[7]
+func (x *CategoryPrice) Reset() {
+	*x = CategoryPrice{}
+	mi := &file_cinema_cinema_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConfigureCinemaResponse) String() string {
+func (x *CategoryPrice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigureCinemaResponse) ProtoMessage() {}
+func (*CategoryPrice) ProtoMessage() {}
 
-func (x *ConfigureCinemaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_cinema_proto_msgTypes[7]
+func (x *CategoryPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -447,43 +5837,49 @@ func (x *ConfigureCinemaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigureCinemaResponse.ProtoReflect.Descriptor instead.
-func (*ConfigureCinemaResponse) Descriptor() ([]byte, []int) {
-	return file_cinema_cinema_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use CategoryPrice.ProtoReflect.Descriptor instead.
+func (*CategoryPrice) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *ConfigureCinemaResponse) GetId() string {
+func (x *CategoryPrice) GetCategory() SeatCategory {
 	if x != nil {
-		return x.Id
+		return x.Category
 	}
-	return ""
+	return SeatCategory_SEAT_CATEGORY_STANDARD
 }
 
-// Represents a seat by its row and column coordinates
-type Seat struct {
+func (x *CategoryPrice) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type SeatPrice struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Row    int32 `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`       // Row index (0-based)
-	Column int32 `protobuf:"varint,2,opt,name=column,proto3" json:"column,omitempty"` // Column index (0-based)
+	Seat  *Seat `protobuf:"bytes,1,opt,name=seat,proto3" json:"seat,omitempty"`
+	Price int64 `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"` // Price in the currency's minor unit
 }
 
-func (x *Seat) Reset() {
-	*x = Seat{}
-	mi := &file_cinema_cinema_proto_msgTypes[8]
+func (x *SeatPrice) Reset() {
+	*x = SeatPrice{}
+	mi := &file_cinema_cinema_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Seat) String() string {
+func (x *SeatPrice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Seat) ProtoMessage() {}
+func (*SeatPrice) ProtoMessage() {}
 
-func (x *Seat) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_cinema_proto_msgTypes[8]
+func (x *SeatPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_cinema_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -494,21 +5890,21 @@ func (x *Seat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Seat.ProtoReflect.Descriptor instead.
-func (*Seat) Descriptor() ([]byte, []int) {
-	return file_cinema_cinema_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use SeatPrice.ProtoReflect.Descriptor instead.
+func (*SeatPrice) Descriptor() ([]byte, []int) {
+	return file_cinema_cinema_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *Seat) GetRow() int32 {
+func (x *SeatPrice) GetSeat() *Seat {
 	if x != nil {
-		return x.Row
+		return x.Seat
 	}
-	return 0
+	return nil
 }
 
-func (x *Seat) GetColumn() int32 {
+func (x *SeatPrice) GetPrice() int64 {
 	if x != nil {
-		return x.Column
+		return x.Price
 	}
 	return 0
 }
@@ -524,101 +5920,1071 @@ var file_cinema_cinema_proto_rawDesc = []byte{
 	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
 	0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f,
 	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x69, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x75, 0x72, 0x65, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
+	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd2, 0x05, 0x0a, 0x16, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x44, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x69, 0x6e, 0x65,
+	0x6d, 0x61, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x2d, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x37, 0x0a, 0x09,
+	0x73, 0x68, 0x6f, 0x77, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x68, 0x6f,
+	0x77, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69,
+	0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12,
+	0x37, 0x0a, 0x10, 0x75, 0x6e, 0x73, 0x65, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x65,
+	0x61, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x69, 0x6e, 0x65,
+	0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x52, 0x0f, 0x75, 0x6e, 0x73, 0x65, 0x6c, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d,
+	0x61, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x06, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x61, 0x64, 0x6a, 0x61, 0x63, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d,
+	0x61, 0x2e, 0x41, 0x64, 0x6a, 0x61, 0x63, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x09, 0x61, 0x64, 0x6a, 0x61, 0x63, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x39, 0x0a, 0x0a,
+	0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x70, 0x61, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x0a, 0x73, 0x65, 0x70,
+	0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x69, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x69, 0x6e, 0x65,
+	0x6d, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x19, 0x0a,
+	0x08, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf1,
+	0x05, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69,
+	0x6e, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3a, 0x0a,
+	0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x06, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x69, 0x6e, 0x65,
+	0x6d, 0x61, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x77, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x48, 0x0a,
+	0x12, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x10, 0x75, 0x6e, 0x73, 0x65, 0x6c,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x52,
+	0x0f, 0x75, 0x6e, 0x73, 0x65, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73,
+	0x12, 0x2b, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x35, 0x0a,
+	0x09, 0x61, 0x64, 0x6a, 0x61, 0x63, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x41, 0x64, 0x6a, 0x61, 0x63, 0x65,
+	0x6e, 0x63, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x09, 0x61, 0x64, 0x6a, 0x61, 0x63,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d,
+	0x61, 0x2e, 0x53, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x0a, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x34, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63,
+	0x6c, 0x65, 0x61, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xf9, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d,
+	0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x61,
+	0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69,
+	0x6e, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d,
+	0x69, 0x6e, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x65, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53,
+	0x65, 0x61, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x6f, 0x63, 0x63, 0x75,
+	0x70, 0x61, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6d, 0x69, 0x6e,
+	0x4f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x78,
+	0x5f, 0x6f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0c, 0x6d, 0x61, 0x78, 0x4f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x22, 0x6e,
+	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e,
+	0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x63,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xf0,
+	0x01, 0x0a, 0x0d, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x63, 0x63, 0x75, 0x70, 0x61,
+	0x6e, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6f, 0x63, 0x63, 0x75, 0x70,
+	0x61, 0x6e, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x49,
+	0x64, 0x22, 0x3b, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x69, 0x6e, 0x65, 0x6d,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x86,
+	0x01, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e,
+	0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x31, 0x0a, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x2a, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x95, 0x01, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x69,
+	0x6e, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x69,
+	0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a,
+	0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x22, 0x64, 0x0a, 0x0b, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x49, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x48, 0x00, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x22, 0x2b, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4f,
+	0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x6f, 0x0a, 0x0d, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x22, 0x71, 0x0a, 0x11, 0x44, 0x69, 0x66, 0x66, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x49, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x23,
+	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x69, 0x6e,
+	0x65, 0x6d, 0x61, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x52,
+	0x02, 0x74, 0x6f, 0x22, 0x84, 0x01, 0x0a, 0x12, 0x44, 0x69, 0x66, 0x66, 0x43, 0x69, 0x6e, 0x65,
+	0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x72,
+	0x6f, 0x6d, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x74, 0x6f, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x74, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0xce, 0x01, 0x0a, 0x0a, 0x53,
+	0x65, 0x61, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x73, 0x65, 0x61,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x2e, 0x53, 0x65, 0x61, 0x74, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x33, 0x0a, 0x0b, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x12, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x2f, 0x0a, 0x09, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x6f, 0x0a, 0x13, 0x52,
+	0x65, 0x76, 0x65, 0x72, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x23, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x61,
+	0x72, 0x64, 0x5f, 0x6e, 0x65, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x4e, 0x65, 0x77, 0x65, 0x72, 0x22, 0x5e, 0x0a, 0x14,
+	0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c,
+	0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0xaf, 0x01, 0x0a,
+	0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x69, 0x6e, 0x65, 0x6d,
+	0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x69, 0x6e, 0x65,
+	0x6d, 0x61, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x05,
+	0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x45,
+	0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x69, 0x6e, 0x65,
+	0x6d, 0x61, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x9f, 0x02, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x69, 0x6e, 0x65, 0x6d,
+	0x61, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x69, 0x6e, 0x65,
+	0x6d, 0x61, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x74,
+	0x52, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x9b, 0x01, 0x0a, 0x05, 0x56, 0x65, 0x6e, 0x75,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x19, 0x0a, 0x08, 0x68, 0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x68, 0x61, 0x6c, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x42, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56,
+	0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x69, 0x73,
+	0x74, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3b,
+	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x56, 0x65,
+	0x6e, 0x75, 0x65, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x22, 0x24, 0x0a, 0x12, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x7e, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x49,
+	0x64, 0x22, 0xa4, 0x01, 0x0a, 0x07, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
+	0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x49, 0x64, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x43, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x69, 0x6e, 0x65,
+	0x6d, 0x61, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x08, 0x77, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x73, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x6d, 0x0a, 0x1c,
+	0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x63, 0x69,
+	0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x58, 0x0a, 0x1d, 0x4c,
+	0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0a,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0xb6, 0x03, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x69, 0x6e, 0x65, 0x6d,
+	0x61, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x69, 0x6e, 0x65,
+	0x6d, 0x61, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x3d, 0x0a, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x2d,
+	0x0a, 0x1b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x66, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0f, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61,
+	0x74, 0x52, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x67, 0x72, 0x69, 0x64, 0x22, 0x60, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x34, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53,
+	0x65, 0x61, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x6c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x61, 0x74, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x61,
+	0x73, 0x5f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x69, 0x6e,
+	0x65, 0x6d, 0x61, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x52,
+	0x04, 0x61, 0x73, 0x4f, 0x66, 0x22, 0xba, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61,
+	0x74, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69,
+	0x6e, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2c, 0x0a,
+	0x09, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x52, 0x6f,
+	0x77, 0x52, 0x08, 0x73, 0x65, 0x61, 0x74, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65,
+	0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5a, 0x6f,
+	0x6e, 0x65, 0x22, 0x5a, 0x0a, 0x07, 0x53, 0x65, 0x61, 0x74, 0x52, 0x6f, 0x77, 0x12, 0x10, 0x0a,
+	0x03, 0x72, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x27, 0x0a, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65,
+	0x61, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x22, 0xa4,
+	0x01, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x04,
+	0x73, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x69, 0x6e,
+	0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65,
+	0x61, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x64, 0x22, 0xd4, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a,
+	0x0b, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x74,
+	0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x74,
+	0x43, 0x6f, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69,
+	0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x61, 0x64, 0x6a, 0x61, 0x63, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d,
+	0x61, 0x2e, 0x41, 0x64, 0x6a, 0x61, 0x63, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x09, 0x61, 0x64, 0x6a, 0x61, 0x63, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x8b, 0x01, 0x0a,
+	0x14, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x27, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x2b, 0x0a, 0x0f, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x5d, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a,
+	0x0b, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x74,
+	0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x74,
+	0x43, 0x6f, 0x6f, 0x72, 0x64, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x13, 0x4a, 0x6f, 0x69, 0x6e, 0x57,
+	0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x75, 0x74, 0x6f, 0x48, 0x6f, 0x6c, 0x64, 0x12, 0x34,
+	0x0a, 0x08, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x68, 0x6f, 0x6c,
+	0x64, 0x46, 0x6f, 0x72, 0x22, 0x45, 0x0a, 0x14, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x76, 0x0a, 0x18, 0x57,
+	0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a,
+	0x04, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x69,
+	0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x04, 0x68,
+	0x6f, 0x6c, 0x64, 0x22, 0x24, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x6e, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2f, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x6c,
+	0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x26, 0x0a, 0x05, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x48, 0x6f,
+	0x6c, 0x64, 0x52, 0x05, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x22, 0xee, 0x01, 0x0a, 0x0d, 0x57, 0x61,
+	0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x61, 0x75, 0x74, 0x6f, 0x48, 0x6f, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x68,
+	0x6f, 0x6c, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x68, 0x6f, 0x6c, 0x64, 0x46, 0x6f,
+	0x72, 0x12, 0x37, 0x0a, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x22, 0x7f, 0x0a, 0x08, 0x53, 0x65,
+	0x61, 0x74, 0x48, 0x6f, 0x6c, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65,
+	0x61, 0x74, 0x52, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x75, 0x6e, 0x74,
+	0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x61, 0x0a, 0x13, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x3a, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xba, 0x48,
+	0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x8a,
+	0x01, 0x0a, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x63, 0x6f, 0x6f, 0x72, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x2e, 0x53, 0x65, 0x61, 0x74, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52,
+	0x0a, 0x73, 0x65, 0x61, 0x74, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x14,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x38,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x22, 0x8c, 0x01, 0x0a, 0x16, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61,
+	0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x22, 0xed, 0x01, 0x0a, 0x12, 0x50, 0x6c, 0x61, 0x6e, 0x53, 0x65, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x69,
+	0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92,
+	0x01, 0x02, 0x08, 0x01, 0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x33, 0x0a,
+	0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x70,
+	0x70, 0x6c, 0x79, 0x22, 0x5f, 0x0a, 0x05, 0x50, 0x61, 0x72, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02,
+	0x20, 0x00, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x22, 0xec, 0x01, 0x0a, 0x13, 0x50, 0x6c, 0x61, 0x6e, 0x53, 0x65, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0b,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x6e, 0x73, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x73, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x65,
+	0x6f, 0x70, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x50, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x73, 0x65, 0x61, 0x74, 0x65, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x65, 0x64, 0x22, 0x54, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65,
+	0x61, 0x74, 0x52, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x22, 0x6e, 0x0a, 0x16, 0x41, 0x6e, 0x61,
+	0x6c, 0x79, 0x7a, 0x65, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x44,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x53, 0x69, 0x7a, 0x65, 0x73, 0x22, 0xb7, 0x01, 0x0a, 0x17, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x7a, 0x65, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73,
+	0x65, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x64, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x6f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x6f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x36, 0x0a, 0x09, 0x73,
+	0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x52, 0x09, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72,
+	0x69, 0x6f, 0x73, 0x22, 0xc9, 0x01, 0x0a, 0x10, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x6d, 0x69, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x61, 0x74,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x65, 0x61, 0x64, 0x53, 0x65, 0x61, 0x74, 0x73,
+	0x12, 0x2d, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43,
+	0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22,
+	0x78, 0x0a, 0x0d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x29,
+	0x0a, 0x10, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x13, 0x53, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x26, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x09,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x22, 0x3a, 0x0a, 0x14, 0x53, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x22, 0x0a, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x52, 0x05, 0x73,
+	0x65, 0x61, 0x74, 0x73, 0x22, 0x29, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x65, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x78, 0x0a, 0x04, 0x53, 0x65, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x12, 0x30, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61,
+	0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x7f, 0x0a, 0x11, 0x53, 0x65, 0x70,
+	0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x74, 0x61, 0x6c, 0x12, 0x1a,
+	0x0a, 0x08, 0x76, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x76, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69,
+	0x61, 0x67, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x69,
+	0x61, 0x67, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x22, 0x65, 0x0a, 0x12, 0x45, 0x78,
+	0x70, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x20, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x52, 0x04, 0x73, 0x65,
+	0x61, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x8b, 0x01, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x61,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x73, 0x65, 0x61,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x2e, 0x53, 0x65, 0x61, 0x74, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x63,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x22,
+	0xa6, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x12, 0x2e, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x2b, 0x0a, 0x0a, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61,
+	0x74, 0x52, 0x09, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x53, 0x65, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xb3, 0x01, 0x0a, 0x10, 0x44, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x41, 0x0a,
+	0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d,
 	0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x22, 0x7c, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x69, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x2e, 0x53, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x52, 0x0a, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2f,
+	0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x8a, 0x01, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x69,
+	0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x44, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x69, 0x6e, 0x65,
+	0x6d, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x58, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x02, 0x61, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x02, 0x61, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e,
+	0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x37, 0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x76, 0x0a, 0x0f, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x74,
+	0x52, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x6f, 0x6f, 0x5f, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x74,
+	0x6f, 0x6f, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x6f, 0x22, 0x59, 0x0a, 0x0f, 0x41, 0x64, 0x6a,
+	0x61, 0x63, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x25, 0x0a, 0x04,
+	0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x69, 0x6e,
+	0x65, 0x6d, 0x61, 0x2e, 0x41, 0x64, 0x6a, 0x61, 0x63, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x04, 0x72,
+	0x75, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x70, 0x6c,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53,
+	0x70, 0x6c, 0x69, 0x74, 0x22, 0x99, 0x01, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x72, 0x6f, 0x77, 0x5f, 0x73, 0x74, 0x79, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x2e, 0x52, 0x6f, 0x77, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x52, 0x08,
+	0x72, 0x6f, 0x77, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6b, 0x69, 0x70,
+	0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x73, 0x6b, 0x69, 0x70, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x09, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x69, 0x6e, 0x67,
+	0x22, 0x4c, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x12, 0x1c, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x98,
+	0x01, 0x0a, 0x12, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x2e, 0x53, 0x65, 0x61, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x22, 0x0a, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e,
+	0x53, 0x65, 0x61, 0x74, 0x52, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x57, 0x0a, 0x0d, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x22, 0x43, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x20, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x52, 0x04, 0x73, 0x65, 0x61,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x2a, 0x55, 0x0a, 0x0c, 0x4c, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x41, 0x59, 0x4f, 0x55,
+	0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x00, 0x12,
+	0x16, 0x0a, 0x12, 0x4c, 0x41, 0x59, 0x4f, 0x55, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
+	0x5f, 0x59, 0x41, 0x4d, 0x4c, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x41, 0x59, 0x4f, 0x55,
+	0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x02, 0x2a, 0x87,
+	0x01, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
+	0x1d, 0x0a, 0x19, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x18,
+	0x0a, 0x14, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x44, 0x45, 0x41, 0x44, 0x10, 0x03, 0x2a, 0x98, 0x01, 0x0a, 0x0a, 0x53, 0x65, 0x61,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x45, 0x41, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45,
+	0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x45, 0x41, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10,
+	0x53, 0x45, 0x41, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x48, 0x45, 0x4c, 0x44,
+	0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x45, 0x41, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f, 0x44, 0x49, 0x53,
+	0x54, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x45, 0x41, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x45, 0x4c, 0x4c, 0x41, 0x42, 0x4c,
+	0x45, 0x10, 0x04, 0x2a, 0x46, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x4f, 0x42, 0x4a,
+	0x45, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x50, 0x45, 0x4f, 0x50, 0x4c, 0x45, 0x10, 0x00, 0x12,
+	0x1a, 0x0a, 0x16, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x56,
+	0x45, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x45, 0x53, 0x10, 0x01, 0x2a, 0xb0, 0x01, 0x0a, 0x0e,
+	0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1f,
+	0x0a, 0x1b, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x20, 0x0a, 0x1c, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10,
+	0x01, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x1e, 0x0a, 0x1a, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x45, 0x4c, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x12,
+	0x1d, 0x0a, 0x19, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x04, 0x2a, 0x4f,
+	0x0a, 0x09, 0x41, 0x64, 0x6a, 0x61, 0x63, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x11, 0x0a, 0x0d, 0x41,
+	0x44, 0x4a, 0x41, 0x43, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x41, 0x4e, 0x59, 0x10, 0x00, 0x12, 0x16,
+	0x0a, 0x12, 0x41, 0x44, 0x4a, 0x41, 0x43, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x53, 0x41, 0x4d, 0x45,
+	0x5f, 0x52, 0x4f, 0x57, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x44, 0x4a, 0x41, 0x43, 0x45,
+	0x4e, 0x43, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x2a,
+	0x49, 0x0a, 0x0d, 0x52, 0x6f, 0x77, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x74, 0x79, 0x6c, 0x65,
+	0x12, 0x1b, 0x0a, 0x17, 0x52, 0x4f, 0x57, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x5f, 0x53, 0x54,
+	0x59, 0x4c, 0x45, 0x5f, 0x4c, 0x45, 0x54, 0x54, 0x45, 0x52, 0x53, 0x10, 0x00, 0x12, 0x1b, 0x0a,
+	0x17, 0x52, 0x4f, 0x57, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x5f, 0x53, 0x54, 0x59, 0x4c, 0x45,
+	0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x53, 0x10, 0x01, 0x2a, 0x90, 0x01, 0x0a, 0x0d, 0x53,
+	0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x1c,
+	0x53, 0x45, 0x41, 0x54, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x4c,
+	0x45, 0x46, 0x54, 0x5f, 0x54, 0x4f, 0x5f, 0x52, 0x49, 0x47, 0x48, 0x54, 0x10, 0x00, 0x12, 0x20,
+	0x0a, 0x1c, 0x53, 0x45, 0x41, 0x54, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x49, 0x4e, 0x47,
+	0x5f, 0x52, 0x49, 0x47, 0x48, 0x54, 0x5f, 0x54, 0x4f, 0x5f, 0x4c, 0x45, 0x46, 0x54, 0x10, 0x01,
+	0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x45, 0x41, 0x54, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x49,
+	0x4e, 0x47, 0x5f, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x43, 0x45, 0x4e, 0x54, 0x45, 0x52, 0x10, 0x02,
+	0x12, 0x1b, 0x0a, 0x17, 0x53, 0x45, 0x41, 0x54, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x49,
+	0x4e, 0x47, 0x5f, 0x4f, 0x44, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x10, 0x03, 0x2a, 0x97, 0x01,
+	0x0a, 0x0c, 0x53, 0x65, 0x61, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1a,
+	0x0a, 0x16, 0x53, 0x45, 0x41, 0x54, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f,
+	0x53, 0x54, 0x41, 0x4e, 0x44, 0x41, 0x52, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x45,
+	0x41, 0x54, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x50, 0x52, 0x45, 0x4d,
+	0x49, 0x55, 0x4d, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x45, 0x41, 0x54, 0x5f, 0x43, 0x41,
+	0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x56, 0x49, 0x50, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18,
+	0x53, 0x45, 0x41, 0x54, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x57, 0x48,
+	0x45, 0x45, 0x4c, 0x43, 0x48, 0x41, 0x49, 0x52, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x45,
+	0x41, 0x54, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x43, 0x4f, 0x4d, 0x50,
+	0x41, 0x4e, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x32, 0x8d, 0x1f, 0x0a, 0x0d, 0x43, 0x69, 0x6e, 0x65,
+	0x6d, 0x61, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7c, 0x0a, 0x0f, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x12, 0x1e, 0x2e, 0x63,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x43,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x43,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2f, 0x73, 0x65, 0x61, 0x74, 0x2f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x12, 0x7f, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x21, 0x2e,
+	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x69, 0x6e,
 	0x65, 0x6d, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
-	0x72, 0x6f, 0x77, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x21,
-	0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x66, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
-	0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35,
-	0x0a, 0x0f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x61, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
-	0x2e, 0x53, 0x65, 0x61, 0x74, 0x52, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
-	0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x22, 0x2a, 0x0a, 0x18, 0x47, 0x65, 0x74,
-	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x7d, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x0b,
-	0x73, 0x65, 0x61, 0x74, 0x5f, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x42,
-	0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x74, 0x43,
-	0x6f, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2b, 0x0a, 0x0f, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x22, 0x5d, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x65, 0x61, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x74, 0x5f,
-	0x63, 0x6f, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63,
-	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92,
-	0x01, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x74, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x73,
-	0x22, 0x29, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x43, 0x69, 0x6e,
-	0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x30, 0x0a, 0x04, 0x53,
-	0x65, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x32, 0xe8, 0x04,
-	0x0a, 0x0d, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x7c, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x43, 0x69, 0x6e, 0x65,
-	0x6d, 0x61, 0x12, 0x1e, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x75, 0x72, 0x65, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x75, 0x72, 0x65, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2f, 0x73,
-	0x65, 0x61, 0x74, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x12, 0x7f, 0x0a,
-	0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x21, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x1a, 0x17, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x27, 0x3a, 0x01, 0x2a, 0x1a, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69,
+	0x6e, 0x65, 0x6d, 0x61, 0x2f, 0x73, 0x65, 0x61, 0x74, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x5f, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x12, 0x1a, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x12, 0x62, 0x0a, 0x0c, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x12, 0x1b, 0x2e, 0x63, 0x69, 0x6e, 0x65,
+	0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e,
 	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x1a, 0x22, 0x2f, 0x61, 0x70, 0x69,
+	0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x2a, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x68, 0x0a,
+	0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x12, 0x1b, 0x2e,
+	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x69, 0x6e,
+	0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x69, 0x6e,
+	0x65, 0x6d, 0x61, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17,
+	0x12, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x6e, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x12, 0x1b, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a,
+	0x22, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x2f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x70, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x63, 0x69, 0x6e,
+	0x65, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x69, 0x6e,
+	0x65, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x64,
+	0x69, 0x74, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x53, 0x0a, 0x0b, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x1a, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d,
+	0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x56, 0x65,
+	0x6e, 0x75, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x12, 0x5b,
+	0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x63,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x12, 0x5f, 0x0a, 0x0b, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x1a, 0x2e, 0x63, 0x69, 0x6e,
+	0x65, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x2a, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x5b, 0x0a, 0x0d,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x1c, 0x2e,
+	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x63, 0x69,
+	0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x22, 0x1b, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x63, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x1b, 0x2e, 0x63, 0x69, 0x6e, 0x65,
+	0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x65,
+	0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12,
+	0x1c, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x2a, 0x15,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x89, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x24, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x84, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x74, 0x72, 0x79, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x23, 0x2e, 0x63, 0x69, 0x6e,
+	0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28,
+	0x22, 0x26, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x2f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x2f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x12, 0x7f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x20, 0x2e,
+	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69,
 	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2f, 0x73, 0x65, 0x61, 0x74, 0x2f,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x7f,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65,
-	0x61, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x47,
-	0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x64, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x61, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x74, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x61, 0x74, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2f, 0x73, 0x65, 0x61, 0x74, 0x2f, 0x6d, 0x61, 0x70, 0x12,
+	0x6b, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x61, 0x74, 0x12, 0x1a,
+	0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x53,
+	0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x69, 0x6e,
+	0x65, 0x6d, 0x61, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x61, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12,
+	0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2f,
+	0x73, 0x65, 0x61, 0x74, 0x2f, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x12, 0x88, 0x01, 0x0a,
+	0x16, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2f, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x8a, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x22, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24,
+	0x12, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x2f, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x7c, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x69, 0x6e, 0x65,
+	0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x69, 0x6e,
+	0x65, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d,
+	0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x60, 0x0a, 0x0a, 0x44, 0x69, 0x66, 0x66, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x12, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x43, 0x69,
+	0x6e, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x69,
+	0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12,
+	0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2f,
+	0x64, 0x69, 0x66, 0x66, 0x12, 0x6b, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x43, 0x69,
+	0x6e, 0x65, 0x6d, 0x61, 0x12, 0x1b, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65,
+	0x76, 0x65, 0x72, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72,
+	0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2f, 0x72, 0x65, 0x76, 0x65, 0x72,
+	0x74, 0x12, 0x71, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x65, 0x61, 0x74,
+	0x73, 0x12, 0x1b, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53,
+	0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2f, 0x73, 0x65, 0x61, 0x74, 0x2f, 0x72, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x12, 0x69, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x65,
+	0x61, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f,
-	0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
-	0x2f, 0x73, 0x65, 0x61, 0x74, 0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12,
-	0x6c, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12,
-	0x1b, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63,
-	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a,
-	0x22, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
-	0x2f, 0x73, 0x65, 0x61, 0x74, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x12, 0x69, 0x0a,
-	0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x63,
-	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x65, 0x61, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d,
-	0x61, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2f, 0x73, 0x65, 0x61,
-	0x74, 0x2f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x80, 0x01, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
-	0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x42, 0x0b, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x74, 0x33, 0x32, 0x30, 0x31, 0x76, 0x2f, 0x73, 0x65, 0x61, 0x74, 0x2d, 0x61,
-	0x72, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x63,
-	0x69, 0x6e, 0x65, 0x6d, 0x61, 0xa2, 0x02, 0x03, 0x43, 0x58, 0x58, 0xaa, 0x02, 0x06, 0x43, 0x69,
-	0x6e, 0x65, 0x6d, 0x61, 0xca, 0x02, 0x06, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0xe2, 0x02, 0x12,
-	0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x06, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x6e,
+	0x65, 0x6d, 0x61, 0x2f, 0x73, 0x65, 0x61, 0x74, 0x2f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12,
+	0x71, 0x0a, 0x0c, 0x4a, 0x6f, 0x69, 0x6e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x12,
+	0x1b, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x57, 0x61, 0x69,
+	0x74, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2f, 0x77, 0x61, 0x69, 0x74, 0x6c, 0x69,
+	0x73, 0x74, 0x12, 0x70, 0x0a, 0x0d, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x57, 0x61, 0x69, 0x74, 0x6c,
+	0x69, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x57, 0x61, 0x69,
+	0x74, 0x6c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2f, 0x77, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x6c,
+	0x65, 0x61, 0x76, 0x65, 0x12, 0x7f, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x57, 0x61, 0x69, 0x74, 0x6c,
+	0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x63, 0x69,
+	0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x69, 0x6e, 0x65,
+	0x6d, 0x61, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x6e,
+	0x65, 0x6d, 0x61, 0x2f, 0x77, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x67, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x57, 0x61, 0x69, 0x74,
+	0x6c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x47, 0x65,
+	0x74, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x69,
+	0x74, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2f, 0x77, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x75,
+	0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x48, 0x6f, 0x6c, 0x64, 0x12, 0x1c, 0x2e,
+	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x69,
+	0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x3a, 0x01, 0x2a, 0x22,
+	0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2f,
+	0x77, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x68, 0x6f, 0x6c, 0x64, 0x2f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x12, 0x75, 0x0a, 0x0b, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x48, 0x6f, 0x6c, 0x64, 0x12, 0x1c, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x57, 0x61,
+	0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x29, 0x3a, 0x01, 0x2a, 0x22, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2f, 0x77, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x2f,
+	0x68, 0x6f, 0x6c, 0x64, 0x2f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x0c,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x12, 0x1b, 0x2e, 0x63,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x69, 0x6e, 0x65,
+	0x6d, 0x61, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a,
+	0x01, 0x2a, 0x22, 0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65,
+	0x6d, 0x61, 0x2f, 0x73, 0x65, 0x61, 0x74, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2d, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x12, 0x6b, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x6e, 0x53, 0x65, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x50, 0x6c,
+	0x61, 0x6e, 0x53, 0x65, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x53, 0x65,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2f, 0x73, 0x65, 0x61, 0x74, 0x2f, 0x70, 0x6c,
+	0x61, 0x6e, 0x12, 0x73, 0x0a, 0x0f, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x43, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2f, 0x63,
+	0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x6e, 0x0a, 0x0c, 0x53, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2f, 0x73, 0x65, 0x61, 0x74, 0x2f,
+	0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x42, 0x80, 0x01, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x2e,
+	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x42, 0x0b, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x74, 0x33, 0x32, 0x30, 0x31, 0x76, 0x2f, 0x73, 0x65, 0x61, 0x74, 0x2d, 0x61, 0x72,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x63, 0x69,
+	0x6e, 0x65, 0x6d, 0x61, 0xa2, 0x02, 0x03, 0x43, 0x58, 0x58, 0xaa, 0x02, 0x06, 0x43, 0x69, 0x6e,
+	0x65, 0x6d, 0x61, 0xca, 0x02, 0x06, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0xe2, 0x02, 0x12, 0x43,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x06, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -633,37 +6999,283 @@ func file_cinema_cinema_proto_rawDescGZIP() []byte {
 	return file_cinema_cinema_proto_rawDescData
 }
 
-var file_cinema_cinema_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_cinema_cinema_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_cinema_cinema_proto_msgTypes = make([]protoimpl.MessageInfo, 84)
 var file_cinema_cinema_proto_goTypes = []any{
-	(*ConfigureCinemaRequest)(nil),    // 0: cinema.ConfigureCinemaRequest
-	(*UpdateCinemaConfigRequest)(nil), // 1: cinema.UpdateCinemaConfigRequest
-	(*GetAvailableSeatsResponse)(nil), // 2: cinema.GetAvailableSeatsResponse
-	(*GetAvailableSeatsRequest)(nil),  // 3: cinema.GetAvailableSeatsRequest
-	(*ReserveSeatsRequest)(nil),       // 4: cinema.ReserveSeatsRequest
-	(*SuccessResponse)(nil),           // 5: cinema.SuccessResponse
-	(*CancelSeatsRequest)(nil),        // 6: cinema.CancelSeatsRequest
-	(*ConfigureCinemaResponse)(nil),   // 7: cinema.ConfigureCinemaResponse
-	(*Seat)(nil),                      // 8: cinema.Seat
+	(LayoutFormat)(0),                      // 0: cinema.LayoutFormat
+	(DeliveryStatus)(0),                    // 1: cinema.DeliveryStatus
+	(SeatStatus)(0),                        // 2: cinema.SeatStatus
+	(PlanObjective)(0),                     // 3: cinema.PlanObjective
+	(ConflictReason)(0),                    // 4: cinema.ConflictReason
+	(Adjacency)(0),                         // 5: cinema.Adjacency
+	(RowLabelStyle)(0),                     // 6: cinema.RowLabelStyle
+	(SeatNumbering)(0),                     // 7: cinema.SeatNumbering
+	(SeatCategory)(0),                      // 8: cinema.SeatCategory
+	(*ConfigureCinemaRequest)(nil),         // 9: cinema.ConfigureCinemaRequest
+	(*UpdateCinemaConfigRequest)(nil),      // 10: cinema.UpdateCinemaConfigRequest
+	(*ListCinemasRequest)(nil),             // 11: cinema.ListCinemasRequest
+	(*ListCinemasResponse)(nil),            // 12: cinema.ListCinemasResponse
+	(*CinemaSummary)(nil),                  // 13: cinema.CinemaSummary
+	(*DeleteCinemaRequest)(nil),            // 14: cinema.DeleteCinemaRequest
+	(*ExportCinemaRequest)(nil),            // 15: cinema.ExportCinemaRequest
+	(*ExportCinemaResponse)(nil),           // 16: cinema.ExportCinemaResponse
+	(*ImportCinemaRequest)(nil),            // 17: cinema.ImportCinemaRequest
+	(*PointInTime)(nil),                    // 18: cinema.PointInTime
+	(*ListCinemaVersionsRequest)(nil),      // 19: cinema.ListCinemaVersionsRequest
+	(*ListCinemaVersionsResponse)(nil),     // 20: cinema.ListCinemaVersionsResponse
+	(*CinemaVersion)(nil),                  // 21: cinema.CinemaVersion
+	(*DiffCinemaRequest)(nil),              // 22: cinema.DiffCinemaRequest
+	(*DiffCinemaResponse)(nil),             // 23: cinema.DiffCinemaResponse
+	(*SeatChange)(nil),                     // 24: cinema.SeatChange
+	(*RevertCinemaRequest)(nil),            // 25: cinema.RevertCinemaRequest
+	(*RevertCinemaResponse)(nil),           // 26: cinema.RevertCinemaResponse
+	(*ListAuditEventsRequest)(nil),         // 27: cinema.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),        // 28: cinema.ListAuditEventsResponse
+	(*AuditEvent)(nil),                     // 29: cinema.AuditEvent
+	(*Venue)(nil),                          // 30: cinema.Venue
+	(*CreateVenueRequest)(nil),             // 31: cinema.CreateVenueRequest
+	(*ListVenuesRequest)(nil),              // 32: cinema.ListVenuesRequest
+	(*ListVenuesResponse)(nil),             // 33: cinema.ListVenuesResponse
+	(*DeleteVenueRequest)(nil),             // 34: cinema.DeleteVenueRequest
+	(*CreateWebhookRequest)(nil),           // 35: cinema.CreateWebhookRequest
+	(*Webhook)(nil),                        // 36: cinema.Webhook
+	(*ListWebhooksRequest)(nil),            // 37: cinema.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),           // 38: cinema.ListWebhooksResponse
+	(*DeleteWebhookRequest)(nil),           // 39: cinema.DeleteWebhookRequest
+	(*ListWebhookDeliveriesRequest)(nil),   // 40: cinema.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),  // 41: cinema.ListWebhookDeliveriesResponse
+	(*WebhookDelivery)(nil),                // 42: cinema.WebhookDelivery
+	(*RetryWebhookDeliveryRequest)(nil),    // 43: cinema.RetryWebhookDeliveryRequest
+	(*GetAvailableSeatsResponse)(nil),      // 44: cinema.GetAvailableSeatsResponse
+	(*GetAvailableSeatsRequest)(nil),       // 45: cinema.GetAvailableSeatsRequest
+	(*GetSeatMapRequest)(nil),              // 46: cinema.GetSeatMapRequest
+	(*GetSeatMapResponse)(nil),             // 47: cinema.GetSeatMapResponse
+	(*SeatRow)(nil),                        // 48: cinema.SeatRow
+	(*SeatState)(nil),                      // 49: cinema.SeatState
+	(*ReserveSeatsRequest)(nil),            // 50: cinema.ReserveSeatsRequest
+	(*ReserveSeatsResponse)(nil),           // 51: cinema.ReserveSeatsResponse
+	(*SuccessResponse)(nil),                // 52: cinema.SuccessResponse
+	(*CancelSeatsRequest)(nil),             // 53: cinema.CancelSeatsRequest
+	(*JoinWaitlistRequest)(nil),            // 54: cinema.JoinWaitlistRequest
+	(*WaitlistGroupRequest)(nil),           // 55: cinema.WaitlistGroupRequest
+	(*WaitlistPositionResponse)(nil),       // 56: cinema.WaitlistPositionResponse
+	(*GetWaitlistRequest)(nil),             // 57: cinema.GetWaitlistRequest
+	(*GetWaitlistResponse)(nil),            // 58: cinema.GetWaitlistResponse
+	(*WaitlistEntry)(nil),                  // 59: cinema.WaitlistEntry
+	(*SeatHold)(nil),                       // 60: cinema.SeatHold
+	(*BatchReserveRequest)(nil),            // 61: cinema.BatchReserveRequest
+	(*GroupReservation)(nil),               // 62: cinema.GroupReservation
+	(*BatchReserveResponse)(nil),           // 63: cinema.BatchReserveResponse
+	(*GroupReservationResult)(nil),         // 64: cinema.GroupReservationResult
+	(*PlanSeatingRequest)(nil),             // 65: cinema.PlanSeatingRequest
+	(*Party)(nil),                          // 66: cinema.Party
+	(*PlanSeatingResponse)(nil),            // 67: cinema.PlanSeatingResponse
+	(*PartyAssignment)(nil),                // 68: cinema.PartyAssignment
+	(*AnalyzeCapacityRequest)(nil),         // 69: cinema.AnalyzeCapacityRequest
+	(*AnalyzeCapacityResponse)(nil),        // 70: cinema.AnalyzeCapacityResponse
+	(*CapacityScenario)(nil),               // 71: cinema.CapacityScenario
+	(*GroupCapacity)(nil),                  // 72: cinema.GroupCapacity
+	(*SuggestSeatsRequest)(nil),            // 73: cinema.SuggestSeatsRequest
+	(*SuggestSeatsResponse)(nil),           // 74: cinema.SuggestSeatsResponse
+	(*ConfigureCinemaResponse)(nil),        // 75: cinema.ConfigureCinemaResponse
+	(*Seat)(nil),                           // 76: cinema.Seat
+	(*SeparationProfile)(nil),              // 77: cinema.SeparationProfile
+	(*ExplainSeatRequest)(nil),             // 78: cinema.ExplainSeatRequest
+	(*ExplainSeatResponse)(nil),            // 79: cinema.ExplainSeatResponse
+	(*SeatConflict)(nil),                   // 80: cinema.SeatConflict
+	(*DistancingPolicy)(nil),               // 81: cinema.DistancingPolicy
+	(*ListDistancingPoliciesRequest)(nil),  // 82: cinema.ListDistancingPoliciesRequest
+	(*ListDistancingPoliciesResponse)(nil), // 83: cinema.ListDistancingPoliciesResponse
+	(*GetPolicyViolationsRequest)(nil),     // 84: cinema.GetPolicyViolationsRequest
+	(*GetPolicyViolationsResponse)(nil),    // 85: cinema.GetPolicyViolationsResponse
+	(*PolicyViolation)(nil),                // 86: cinema.PolicyViolation
+	(*AdjacencyPolicy)(nil),                // 87: cinema.AdjacencyPolicy
+	(*LabelScheme)(nil),                    // 88: cinema.LabelScheme
+	(*SeatRegion)(nil),                     // 89: cinema.SeatRegion
+	(*CategoryAssignment)(nil),             // 90: cinema.CategoryAssignment
+	(*CategoryPrice)(nil),                  // 91: cinema.CategoryPrice
+	(*SeatPrice)(nil),                      // 92: cinema.SeatPrice
+	(*timestamppb.Timestamp)(nil),          // 93: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),            // 94: google.protobuf.Duration
 }
 var file_cinema_cinema_proto_depIdxs = []int32{
-	8, // 0: cinema.GetAvailableSeatsResponse.available_seats:type_name -> cinema.Seat
-	8, // 1: cinema.ReserveSeatsRequest.seat_coords:type_name -> cinema.Seat
-	8, // 2: cinema.CancelSeatsRequest.seat_coords:type_name -> cinema.Seat
-	0, // 3: cinema.CinemaService.ConfigureCinema:input_type -> cinema.ConfigureCinemaRequest
-	1, // 4: cinema.CinemaService.UpdateCinemaConfig:input_type -> cinema.UpdateCinemaConfigRequest
-	3, // 5: cinema.CinemaService.GetAvailableSeats:input_type -> cinema.GetAvailableSeatsRequest
-	4, // 6: cinema.CinemaService.ReserveSeats:input_type -> cinema.ReserveSeatsRequest
-	6, // 7: cinema.CinemaService.CancelSeats:input_type -> cinema.CancelSeatsRequest
-	7, // 8: cinema.CinemaService.ConfigureCinema:output_type -> cinema.ConfigureCinemaResponse
-	5, // 9: cinema.CinemaService.UpdateCinemaConfig:output_type -> cinema.SuccessResponse
-	2, // 10: cinema.CinemaService.GetAvailableSeats:output_type -> cinema.GetAvailableSeatsResponse
-	5, // 11: cinema.CinemaService.ReserveSeats:output_type -> cinema.SuccessResponse
-	5, // 12: cinema.CinemaService.CancelSeats:output_type -> cinema.SuccessResponse
-	8, // [8:13] is the sub-list for method output_type
-	3, // [3:8] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	90,  // 0: cinema.ConfigureCinemaRequest.categories:type_name -> cinema.CategoryAssignment
+	91,  // 1: cinema.ConfigureCinemaRequest.prices:type_name -> cinema.CategoryPrice
+	93,  // 2: cinema.ConfigureCinemaRequest.show_time:type_name -> google.protobuf.Timestamp
+	94,  // 3: cinema.ConfigureCinemaRequest.accessible_release:type_name -> google.protobuf.Duration
+	76,  // 4: cinema.ConfigureCinemaRequest.unsellable_seats:type_name -> cinema.Seat
+	88,  // 5: cinema.ConfigureCinemaRequest.labels:type_name -> cinema.LabelScheme
+	87,  // 6: cinema.ConfigureCinemaRequest.adjacency:type_name -> cinema.AdjacencyPolicy
+	77,  // 7: cinema.ConfigureCinemaRequest.separation:type_name -> cinema.SeparationProfile
+	81,  // 8: cinema.ConfigureCinemaRequest.policies:type_name -> cinema.DistancingPolicy
+	90,  // 9: cinema.UpdateCinemaConfigRequest.categories:type_name -> cinema.CategoryAssignment
+	91,  // 10: cinema.UpdateCinemaConfigRequest.prices:type_name -> cinema.CategoryPrice
+	93,  // 11: cinema.UpdateCinemaConfigRequest.show_time:type_name -> google.protobuf.Timestamp
+	94,  // 12: cinema.UpdateCinemaConfigRequest.accessible_release:type_name -> google.protobuf.Duration
+	76,  // 13: cinema.UpdateCinemaConfigRequest.unsellable_seats:type_name -> cinema.Seat
+	88,  // 14: cinema.UpdateCinemaConfigRequest.labels:type_name -> cinema.LabelScheme
+	87,  // 15: cinema.UpdateCinemaConfigRequest.adjacency:type_name -> cinema.AdjacencyPolicy
+	77,  // 16: cinema.UpdateCinemaConfigRequest.separation:type_name -> cinema.SeparationProfile
+	81,  // 17: cinema.UpdateCinemaConfigRequest.policies:type_name -> cinema.DistancingPolicy
+	13,  // 18: cinema.ListCinemasResponse.cinemas:type_name -> cinema.CinemaSummary
+	0,   // 19: cinema.ExportCinemaRequest.format:type_name -> cinema.LayoutFormat
+	0,   // 20: cinema.ImportCinemaRequest.format:type_name -> cinema.LayoutFormat
+	93,  // 21: cinema.PointInTime.time:type_name -> google.protobuf.Timestamp
+	21,  // 22: cinema.ListCinemaVersionsResponse.versions:type_name -> cinema.CinemaVersion
+	93,  // 23: cinema.CinemaVersion.time:type_name -> google.protobuf.Timestamp
+	18,  // 24: cinema.DiffCinemaRequest.from:type_name -> cinema.PointInTime
+	18,  // 25: cinema.DiffCinemaRequest.to:type_name -> cinema.PointInTime
+	24,  // 26: cinema.DiffCinemaResponse.changes:type_name -> cinema.SeatChange
+	76,  // 27: cinema.SeatChange.seat:type_name -> cinema.Seat
+	2,   // 28: cinema.SeatChange.from_status:type_name -> cinema.SeatStatus
+	2,   // 29: cinema.SeatChange.to_status:type_name -> cinema.SeatStatus
+	18,  // 30: cinema.RevertCinemaRequest.to:type_name -> cinema.PointInTime
+	24,  // 31: cinema.RevertCinemaResponse.changes:type_name -> cinema.SeatChange
+	93,  // 32: cinema.ListAuditEventsRequest.since:type_name -> google.protobuf.Timestamp
+	93,  // 33: cinema.ListAuditEventsRequest.until:type_name -> google.protobuf.Timestamp
+	29,  // 34: cinema.ListAuditEventsResponse.events:type_name -> cinema.AuditEvent
+	93,  // 35: cinema.AuditEvent.time:type_name -> google.protobuf.Timestamp
+	76,  // 36: cinema.AuditEvent.seats:type_name -> cinema.Seat
+	93,  // 37: cinema.Venue.created_at:type_name -> google.protobuf.Timestamp
+	30,  // 38: cinema.ListVenuesResponse.venues:type_name -> cinema.Venue
+	93,  // 39: cinema.Webhook.created_at:type_name -> google.protobuf.Timestamp
+	36,  // 40: cinema.ListWebhooksResponse.webhooks:type_name -> cinema.Webhook
+	1,   // 41: cinema.ListWebhookDeliveriesRequest.status:type_name -> cinema.DeliveryStatus
+	42,  // 42: cinema.ListWebhookDeliveriesResponse.deliveries:type_name -> cinema.WebhookDelivery
+	1,   // 43: cinema.WebhookDelivery.status:type_name -> cinema.DeliveryStatus
+	93,  // 44: cinema.WebhookDelivery.next_attempt:type_name -> google.protobuf.Timestamp
+	93,  // 45: cinema.WebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	93,  // 46: cinema.WebhookDelivery.updated_at:type_name -> google.protobuf.Timestamp
+	76,  // 47: cinema.GetAvailableSeatsResponse.available_seats:type_name -> cinema.Seat
+	8,   // 48: cinema.GetAvailableSeatsRequest.categories:type_name -> cinema.SeatCategory
+	18,  // 49: cinema.GetSeatMapRequest.as_of:type_name -> cinema.PointInTime
+	48,  // 50: cinema.GetSeatMapResponse.seat_rows:type_name -> cinema.SeatRow
+	49,  // 51: cinema.SeatRow.seats:type_name -> cinema.SeatState
+	76,  // 52: cinema.SeatState.seat:type_name -> cinema.Seat
+	2,   // 53: cinema.SeatState.status:type_name -> cinema.SeatStatus
+	76,  // 54: cinema.ReserveSeatsRequest.seat_coords:type_name -> cinema.Seat
+	87,  // 55: cinema.ReserveSeatsRequest.adjacency:type_name -> cinema.AdjacencyPolicy
+	92,  // 56: cinema.ReserveSeatsResponse.items:type_name -> cinema.SeatPrice
+	76,  // 57: cinema.CancelSeatsRequest.seat_coords:type_name -> cinema.Seat
+	94,  // 58: cinema.JoinWaitlistRequest.hold_for:type_name -> google.protobuf.Duration
+	60,  // 59: cinema.WaitlistPositionResponse.hold:type_name -> cinema.SeatHold
+	59,  // 60: cinema.GetWaitlistResponse.entries:type_name -> cinema.WaitlistEntry
+	60,  // 61: cinema.GetWaitlistResponse.holds:type_name -> cinema.SeatHold
+	94,  // 62: cinema.WaitlistEntry.hold_for:type_name -> google.protobuf.Duration
+	93,  // 63: cinema.WaitlistEntry.joined_at:type_name -> google.protobuf.Timestamp
+	76,  // 64: cinema.SeatHold.seats:type_name -> cinema.Seat
+	93,  // 65: cinema.SeatHold.until:type_name -> google.protobuf.Timestamp
+	62,  // 66: cinema.BatchReserveRequest.groups:type_name -> cinema.GroupReservation
+	76,  // 67: cinema.GroupReservation.seat_coords:type_name -> cinema.Seat
+	64,  // 68: cinema.BatchReserveResponse.results:type_name -> cinema.GroupReservationResult
+	92,  // 69: cinema.GroupReservationResult.items:type_name -> cinema.SeatPrice
+	66,  // 70: cinema.PlanSeatingRequest.parties:type_name -> cinema.Party
+	3,   // 71: cinema.PlanSeatingRequest.objective:type_name -> cinema.PlanObjective
+	94,  // 72: cinema.PlanSeatingRequest.timeout:type_name -> google.protobuf.Duration
+	68,  // 73: cinema.PlanSeatingResponse.assignments:type_name -> cinema.PartyAssignment
+	76,  // 74: cinema.PartyAssignment.seats:type_name -> cinema.Seat
+	71,  // 75: cinema.AnalyzeCapacityResponse.scenarios:type_name -> cinema.CapacityScenario
+	72,  // 76: cinema.CapacityScenario.groups:type_name -> cinema.GroupCapacity
+	76,  // 77: cinema.SuggestSeatsResponse.seats:type_name -> cinema.Seat
+	8,   // 78: cinema.Seat.category:type_name -> cinema.SeatCategory
+	76,  // 79: cinema.ExplainSeatRequest.seat:type_name -> cinema.Seat
+	76,  // 80: cinema.ExplainSeatResponse.seat:type_name -> cinema.Seat
+	80,  // 81: cinema.ExplainSeatResponse.conflicts:type_name -> cinema.SeatConflict
+	4,   // 82: cinema.SeatConflict.reason:type_name -> cinema.ConflictReason
+	76,  // 83: cinema.SeatConflict.other_seat:type_name -> cinema.Seat
+	93,  // 84: cinema.DistancingPolicy.effective_from:type_name -> google.protobuf.Timestamp
+	77,  // 85: cinema.DistancingPolicy.separation:type_name -> cinema.SeparationProfile
+	81,  // 86: cinema.ListDistancingPoliciesResponse.policies:type_name -> cinema.DistancingPolicy
+	81,  // 87: cinema.ListDistancingPoliciesResponse.current:type_name -> cinema.DistancingPolicy
+	93,  // 88: cinema.GetPolicyViolationsRequest.at:type_name -> google.protobuf.Timestamp
+	81,  // 89: cinema.GetPolicyViolationsResponse.policy:type_name -> cinema.DistancingPolicy
+	86,  // 90: cinema.GetPolicyViolationsResponse.violations:type_name -> cinema.PolicyViolation
+	76,  // 91: cinema.PolicyViolation.seats:type_name -> cinema.Seat
+	5,   // 92: cinema.AdjacencyPolicy.rule:type_name -> cinema.Adjacency
+	6,   // 93: cinema.LabelScheme.row_style:type_name -> cinema.RowLabelStyle
+	7,   // 94: cinema.LabelScheme.numbering:type_name -> cinema.SeatNumbering
+	76,  // 95: cinema.SeatRegion.from:type_name -> cinema.Seat
+	76,  // 96: cinema.SeatRegion.to:type_name -> cinema.Seat
+	8,   // 97: cinema.CategoryAssignment.category:type_name -> cinema.SeatCategory
+	76,  // 98: cinema.CategoryAssignment.seats:type_name -> cinema.Seat
+	89,  // 99: cinema.CategoryAssignment.regions:type_name -> cinema.SeatRegion
+	8,   // 100: cinema.CategoryPrice.category:type_name -> cinema.SeatCategory
+	76,  // 101: cinema.SeatPrice.seat:type_name -> cinema.Seat
+	9,   // 102: cinema.CinemaService.ConfigureCinema:input_type -> cinema.ConfigureCinemaRequest
+	10,  // 103: cinema.CinemaService.UpdateCinemaConfig:input_type -> cinema.UpdateCinemaConfigRequest
+	11,  // 104: cinema.CinemaService.ListCinemas:input_type -> cinema.ListCinemasRequest
+	14,  // 105: cinema.CinemaService.DeleteCinema:input_type -> cinema.DeleteCinemaRequest
+	15,  // 106: cinema.CinemaService.ExportCinema:input_type -> cinema.ExportCinemaRequest
+	17,  // 107: cinema.CinemaService.ImportCinema:input_type -> cinema.ImportCinemaRequest
+	27,  // 108: cinema.CinemaService.ListAuditEvents:input_type -> cinema.ListAuditEventsRequest
+	31,  // 109: cinema.CinemaService.CreateVenue:input_type -> cinema.CreateVenueRequest
+	32,  // 110: cinema.CinemaService.ListVenues:input_type -> cinema.ListVenuesRequest
+	34,  // 111: cinema.CinemaService.DeleteVenue:input_type -> cinema.DeleteVenueRequest
+	35,  // 112: cinema.CinemaService.CreateWebhook:input_type -> cinema.CreateWebhookRequest
+	37,  // 113: cinema.CinemaService.ListWebhooks:input_type -> cinema.ListWebhooksRequest
+	39,  // 114: cinema.CinemaService.DeleteWebhook:input_type -> cinema.DeleteWebhookRequest
+	40,  // 115: cinema.CinemaService.ListWebhookDeliveries:input_type -> cinema.ListWebhookDeliveriesRequest
+	43,  // 116: cinema.CinemaService.RetryWebhookDelivery:input_type -> cinema.RetryWebhookDeliveryRequest
+	45,  // 117: cinema.CinemaService.GetAvailableSeats:input_type -> cinema.GetAvailableSeatsRequest
+	46,  // 118: cinema.CinemaService.GetSeatMap:input_type -> cinema.GetSeatMapRequest
+	78,  // 119: cinema.CinemaService.ExplainSeat:input_type -> cinema.ExplainSeatRequest
+	82,  // 120: cinema.CinemaService.ListDistancingPolicies:input_type -> cinema.ListDistancingPoliciesRequest
+	84,  // 121: cinema.CinemaService.GetPolicyViolations:input_type -> cinema.GetPolicyViolationsRequest
+	19,  // 122: cinema.CinemaService.ListCinemaVersions:input_type -> cinema.ListCinemaVersionsRequest
+	22,  // 123: cinema.CinemaService.DiffCinema:input_type -> cinema.DiffCinemaRequest
+	25,  // 124: cinema.CinemaService.RevertCinema:input_type -> cinema.RevertCinemaRequest
+	50,  // 125: cinema.CinemaService.ReserveSeats:input_type -> cinema.ReserveSeatsRequest
+	53,  // 126: cinema.CinemaService.CancelSeats:input_type -> cinema.CancelSeatsRequest
+	54,  // 127: cinema.CinemaService.JoinWaitlist:input_type -> cinema.JoinWaitlistRequest
+	55,  // 128: cinema.CinemaService.LeaveWaitlist:input_type -> cinema.WaitlistGroupRequest
+	55,  // 129: cinema.CinemaService.GetWaitlistPosition:input_type -> cinema.WaitlistGroupRequest
+	57,  // 130: cinema.CinemaService.GetWaitlist:input_type -> cinema.GetWaitlistRequest
+	55,  // 131: cinema.CinemaService.ConfirmHold:input_type -> cinema.WaitlistGroupRequest
+	55,  // 132: cinema.CinemaService.ReleaseHold:input_type -> cinema.WaitlistGroupRequest
+	61,  // 133: cinema.CinemaService.BatchReserve:input_type -> cinema.BatchReserveRequest
+	65,  // 134: cinema.CinemaService.PlanSeating:input_type -> cinema.PlanSeatingRequest
+	69,  // 135: cinema.CinemaService.AnalyzeCapacity:input_type -> cinema.AnalyzeCapacityRequest
+	73,  // 136: cinema.CinemaService.SuggestSeats:input_type -> cinema.SuggestSeatsRequest
+	75,  // 137: cinema.CinemaService.ConfigureCinema:output_type -> cinema.ConfigureCinemaResponse
+	52,  // 138: cinema.CinemaService.UpdateCinemaConfig:output_type -> cinema.SuccessResponse
+	12,  // 139: cinema.CinemaService.ListCinemas:output_type -> cinema.ListCinemasResponse
+	52,  // 140: cinema.CinemaService.DeleteCinema:output_type -> cinema.SuccessResponse
+	16,  // 141: cinema.CinemaService.ExportCinema:output_type -> cinema.ExportCinemaResponse
+	75,  // 142: cinema.CinemaService.ImportCinema:output_type -> cinema.ConfigureCinemaResponse
+	28,  // 143: cinema.CinemaService.ListAuditEvents:output_type -> cinema.ListAuditEventsResponse
+	30,  // 144: cinema.CinemaService.CreateVenue:output_type -> cinema.Venue
+	33,  // 145: cinema.CinemaService.ListVenues:output_type -> cinema.ListVenuesResponse
+	52,  // 146: cinema.CinemaService.DeleteVenue:output_type -> cinema.SuccessResponse
+	36,  // 147: cinema.CinemaService.CreateWebhook:output_type -> cinema.Webhook
+	38,  // 148: cinema.CinemaService.ListWebhooks:output_type -> cinema.ListWebhooksResponse
+	52,  // 149: cinema.CinemaService.DeleteWebhook:output_type -> cinema.SuccessResponse
+	41,  // 150: cinema.CinemaService.ListWebhookDeliveries:output_type -> cinema.ListWebhookDeliveriesResponse
+	52,  // 151: cinema.CinemaService.RetryWebhookDelivery:output_type -> cinema.SuccessResponse
+	44,  // 152: cinema.CinemaService.GetAvailableSeats:output_type -> cinema.GetAvailableSeatsResponse
+	47,  // 153: cinema.CinemaService.GetSeatMap:output_type -> cinema.GetSeatMapResponse
+	79,  // 154: cinema.CinemaService.ExplainSeat:output_type -> cinema.ExplainSeatResponse
+	83,  // 155: cinema.CinemaService.ListDistancingPolicies:output_type -> cinema.ListDistancingPoliciesResponse
+	85,  // 156: cinema.CinemaService.GetPolicyViolations:output_type -> cinema.GetPolicyViolationsResponse
+	20,  // 157: cinema.CinemaService.ListCinemaVersions:output_type -> cinema.ListCinemaVersionsResponse
+	23,  // 158: cinema.CinemaService.DiffCinema:output_type -> cinema.DiffCinemaResponse
+	26,  // 159: cinema.CinemaService.RevertCinema:output_type -> cinema.RevertCinemaResponse
+	51,  // 160: cinema.CinemaService.ReserveSeats:output_type -> cinema.ReserveSeatsResponse
+	52,  // 161: cinema.CinemaService.CancelSeats:output_type -> cinema.SuccessResponse
+	56,  // 162: cinema.CinemaService.JoinWaitlist:output_type -> cinema.WaitlistPositionResponse
+	52,  // 163: cinema.CinemaService.LeaveWaitlist:output_type -> cinema.SuccessResponse
+	56,  // 164: cinema.CinemaService.GetWaitlistPosition:output_type -> cinema.WaitlistPositionResponse
+	58,  // 165: cinema.CinemaService.GetWaitlist:output_type -> cinema.GetWaitlistResponse
+	52,  // 166: cinema.CinemaService.ConfirmHold:output_type -> cinema.SuccessResponse
+	52,  // 167: cinema.CinemaService.ReleaseHold:output_type -> cinema.SuccessResponse
+	63,  // 168: cinema.CinemaService.BatchReserve:output_type -> cinema.BatchReserveResponse
+	67,  // 169: cinema.CinemaService.PlanSeating:output_type -> cinema.PlanSeatingResponse
+	70,  // 170: cinema.CinemaService.AnalyzeCapacity:output_type -> cinema.AnalyzeCapacityResponse
+	74,  // 171: cinema.CinemaService.SuggestSeats:output_type -> cinema.SuggestSeatsResponse
+	137, // [137:172] is the sub-list for method output_type
+	102, // [102:137] is the sub-list for method input_type
+	102, // [102:102] is the sub-list for extension type_name
+	102, // [102:102] is the sub-list for extension extendee
+	0,   // [0:102] is the sub-list for field type_name
 }
 
 func init() { file_cinema_cinema_proto_init() }
@@ -671,18 +7283,23 @@ func file_cinema_cinema_proto_init() {
 	if File_cinema_cinema_proto != nil {
 		return
 	}
+	file_cinema_cinema_proto_msgTypes[9].OneofWrappers = []any{
+		(*PointInTime_Version)(nil),
+		(*PointInTime_Time)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cinema_cinema_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      9,
+			NumMessages:   84,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_cinema_cinema_proto_goTypes,
 		DependencyIndexes: file_cinema_cinema_proto_depIdxs,
+		EnumInfos:         file_cinema_cinema_proto_enumTypes,
 		MessageInfos:      file_cinema_cinema_proto_msgTypes,
 	}.Build()
 	File_cinema_cinema_proto = out.File
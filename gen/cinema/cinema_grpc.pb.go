@@ -19,11 +19,41 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CinemaService_ConfigureCinema_FullMethodName    = "/cinema.CinemaService/ConfigureCinema"
-	CinemaService_UpdateCinemaConfig_FullMethodName = "/cinema.CinemaService/UpdateCinemaConfig"
-	CinemaService_GetAvailableSeats_FullMethodName  = "/cinema.CinemaService/GetAvailableSeats"
-	CinemaService_ReserveSeats_FullMethodName       = "/cinema.CinemaService/ReserveSeats"
-	CinemaService_CancelSeats_FullMethodName        = "/cinema.CinemaService/CancelSeats"
+	CinemaService_ConfigureCinema_FullMethodName        = "/cinema.CinemaService/ConfigureCinema"
+	CinemaService_UpdateCinemaConfig_FullMethodName     = "/cinema.CinemaService/UpdateCinemaConfig"
+	CinemaService_ListCinemas_FullMethodName            = "/cinema.CinemaService/ListCinemas"
+	CinemaService_DeleteCinema_FullMethodName           = "/cinema.CinemaService/DeleteCinema"
+	CinemaService_ExportCinema_FullMethodName           = "/cinema.CinemaService/ExportCinema"
+	CinemaService_ImportCinema_FullMethodName           = "/cinema.CinemaService/ImportCinema"
+	CinemaService_ListAuditEvents_FullMethodName        = "/cinema.CinemaService/ListAuditEvents"
+	CinemaService_CreateVenue_FullMethodName            = "/cinema.CinemaService/CreateVenue"
+	CinemaService_ListVenues_FullMethodName             = "/cinema.CinemaService/ListVenues"
+	CinemaService_DeleteVenue_FullMethodName            = "/cinema.CinemaService/DeleteVenue"
+	CinemaService_CreateWebhook_FullMethodName          = "/cinema.CinemaService/CreateWebhook"
+	CinemaService_ListWebhooks_FullMethodName           = "/cinema.CinemaService/ListWebhooks"
+	CinemaService_DeleteWebhook_FullMethodName          = "/cinema.CinemaService/DeleteWebhook"
+	CinemaService_ListWebhookDeliveries_FullMethodName  = "/cinema.CinemaService/ListWebhookDeliveries"
+	CinemaService_RetryWebhookDelivery_FullMethodName   = "/cinema.CinemaService/RetryWebhookDelivery"
+	CinemaService_GetAvailableSeats_FullMethodName      = "/cinema.CinemaService/GetAvailableSeats"
+	CinemaService_GetSeatMap_FullMethodName             = "/cinema.CinemaService/GetSeatMap"
+	CinemaService_ExplainSeat_FullMethodName            = "/cinema.CinemaService/ExplainSeat"
+	CinemaService_ListDistancingPolicies_FullMethodName = "/cinema.CinemaService/ListDistancingPolicies"
+	CinemaService_GetPolicyViolations_FullMethodName    = "/cinema.CinemaService/GetPolicyViolations"
+	CinemaService_ListCinemaVersions_FullMethodName     = "/cinema.CinemaService/ListCinemaVersions"
+	CinemaService_DiffCinema_FullMethodName             = "/cinema.CinemaService/DiffCinema"
+	CinemaService_RevertCinema_FullMethodName           = "/cinema.CinemaService/RevertCinema"
+	CinemaService_ReserveSeats_FullMethodName           = "/cinema.CinemaService/ReserveSeats"
+	CinemaService_CancelSeats_FullMethodName            = "/cinema.CinemaService/CancelSeats"
+	CinemaService_JoinWaitlist_FullMethodName           = "/cinema.CinemaService/JoinWaitlist"
+	CinemaService_LeaveWaitlist_FullMethodName          = "/cinema.CinemaService/LeaveWaitlist"
+	CinemaService_GetWaitlistPosition_FullMethodName    = "/cinema.CinemaService/GetWaitlistPosition"
+	CinemaService_GetWaitlist_FullMethodName            = "/cinema.CinemaService/GetWaitlist"
+	CinemaService_ConfirmHold_FullMethodName            = "/cinema.CinemaService/ConfirmHold"
+	CinemaService_ReleaseHold_FullMethodName            = "/cinema.CinemaService/ReleaseHold"
+	CinemaService_BatchReserve_FullMethodName           = "/cinema.CinemaService/BatchReserve"
+	CinemaService_PlanSeating_FullMethodName            = "/cinema.CinemaService/PlanSeating"
+	CinemaService_AnalyzeCapacity_FullMethodName        = "/cinema.CinemaService/AnalyzeCapacity"
+	CinemaService_SuggestSeats_FullMethodName           = "/cinema.CinemaService/SuggestSeats"
 )
 
 // CinemaServiceClient is the client API for CinemaService service.
@@ -36,12 +66,67 @@ type CinemaServiceClient interface {
 	ConfigureCinema(ctx context.Context, in *ConfigureCinemaRequest, opts ...grpc.CallOption) (*ConfigureCinemaResponse, error)
 	// Configures cinema size and minimum distance between groups
 	UpdateCinemaConfig(ctx context.Context, in *UpdateCinemaConfigRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
+	// Lists the cinemas of the organization of the caller, page by page
+	ListCinemas(ctx context.Context, in *ListCinemasRequest, opts ...grpc.CallOption) (*ListCinemasResponse, error)
+	// Deletes a cinema along with its history, cinemas with reservations are only deleted when forced
+	DeleteCinema(ctx context.Context, in *DeleteCinemaRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
+	// Exports the layout of a cinema, and optionally its reservations
+	ExportCinema(ctx context.Context, in *ExportCinemaRequest, opts ...grpc.CallOption) (*ExportCinemaResponse, error)
+	// Creates a cinema from an exported layout
+	ImportCinema(ctx context.Context, in *ImportCinemaRequest, opts ...grpc.CallOption) (*ConfigureCinemaResponse, error)
+	// Lists the recorded state-changing operations, oldest first, for staff only
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	// Creates a venue of the organization of the caller, its halls are cinemas
+	CreateVenue(ctx context.Context, in *CreateVenueRequest, opts ...grpc.CallOption) (*Venue, error)
+	// Lists the venues of the organization of the caller with their halls
+	ListVenues(ctx context.Context, in *ListVenuesRequest, opts ...grpc.CallOption) (*ListVenuesResponse, error)
+	// Deletes a venue without halls
+	DeleteVenue(ctx context.Context, in *DeleteVenueRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
+	// Subscribes a URL to cinema events, payloads are signed with the secret
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
+	// Lists the deliveries of webhooks, oldest first, dead ones form the dead-letter list
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	// Sends a dead delivery again
+	RetryWebhookDelivery(ctx context.Context, in *RetryWebhookDeliveryRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
 	// Queries available seats that can be purchased together
 	GetAvailableSeats(ctx context.Context, in *GetAvailableSeatsRequest, opts ...grpc.CallOption) (*GetAvailableSeatsResponse, error)
+	// Queries the state of every seat, row by row
+	GetSeatMap(ctx context.Context, in *GetSeatMapRequest, opts ...grpc.CallOption) (*GetSeatMapResponse, error)
+	// Tells whether a group can reserve a seat and every reason why not
+	ExplainSeat(ctx context.Context, in *ExplainSeatRequest, opts ...grpc.CallOption) (*ExplainSeatResponse, error)
+	// Lists the scheduled distancing policies of a cinema
+	ListDistancingPolicies(ctx context.Context, in *ListDistancingPoliciesRequest, opts ...grpc.CallOption) (*ListDistancingPoliciesResponse, error)
+	// Lists the reservations which would break a scheduled distancing policy, the next one by default
+	GetPolicyViolations(ctx context.Context, in *GetPolicyViolationsRequest, opts ...grpc.CallOption) (*GetPolicyViolationsResponse, error)
+	// Lists the stored versions of a cinema, every event of the cinema creates a version
+	ListCinemaVersions(ctx context.Context, in *ListCinemaVersionsRequest, opts ...grpc.CallOption) (*ListCinemaVersionsResponse, error)
+	// Lists the seats whose reservation changed between two points in time
+	DiffCinema(ctx context.Context, in *DiffCinemaRequest, opts ...grpc.CallOption) (*DiffCinemaResponse, error)
+	// Restores the reservations of a previous version which have been cancelled since
+	RevertCinema(ctx context.Context, in *RevertCinemaRequest, opts ...grpc.CallOption) (*RevertCinemaResponse, error)
 	// Reserves specific seats by their (row, column) coordinates
-	ReserveSeats(ctx context.Context, in *ReserveSeatsRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
+	ReserveSeats(ctx context.Context, in *ReserveSeatsRequest, opts ...grpc.CallOption) (*ReserveSeatsResponse, error)
 	// Cancels reservation of specific seats by their (row, column) coordinates
 	CancelSeats(ctx context.Context, in *CancelSeatsRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
+	// Adds a party to the waitlist of a cinema, seats freed by cancellations are then
+	// held for it or offered to it in waitlist order
+	JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*WaitlistPositionResponse, error)
+	LeaveWaitlist(ctx context.Context, in *WaitlistGroupRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
+	GetWaitlistPosition(ctx context.Context, in *WaitlistGroupRequest, opts ...grpc.CallOption) (*WaitlistPositionResponse, error)
+	GetWaitlist(ctx context.Context, in *GetWaitlistRequest, opts ...grpc.CallOption) (*GetWaitlistResponse, error)
+	// Turns the seats held for a waitlisted party into a reservation
+	ConfirmHold(ctx context.Context, in *WaitlistGroupRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
+	ReleaseHold(ctx context.Context, in *WaitlistGroupRequest, opts ...grpc.CallOption) (*SuccessResponse, error)
+	// Reserves seats for several groups at once, either all of them or none
+	BatchReserve(ctx context.Context, in *BatchReserveRequest, opts ...grpc.CallOption) (*BatchReserveResponse, error)
+	// Plans seats for a list of parties, maximizing the people or parties seated
+	PlanSeating(ctx context.Context, in *PlanSeatingRequest, opts ...grpc.CallOption) (*PlanSeatingResponse, error)
+	// Computes how many people fit in the cinema under hypothetical minimum distances
+	AnalyzeCapacity(ctx context.Context, in *AnalyzeCapacityRequest, opts ...grpc.CallOption) (*AnalyzeCapacityResponse, error)
+	// Suggests seats a group can reserve together, without reserving them
+	SuggestSeats(ctx context.Context, in *SuggestSeatsRequest, opts ...grpc.CallOption) (*SuggestSeatsResponse, error)
 }
 
 type cinemaServiceClient struct {
@@ -72,6 +157,136 @@ func (c *cinemaServiceClient) UpdateCinemaConfig(ctx context.Context, in *Update
 	return out, nil
 }
 
+func (c *cinemaServiceClient) ListCinemas(ctx context.Context, in *ListCinemasRequest, opts ...grpc.CallOption) (*ListCinemasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCinemasResponse)
+	err := c.cc.Invoke(ctx, CinemaService_ListCinemas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceClient) DeleteCinema(ctx context.Context, in *DeleteCinemaRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessResponse)
+	err := c.cc.Invoke(ctx, CinemaService_DeleteCinema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceClient) ExportCinema(ctx context.Context, in *ExportCinemaRequest, opts ...grpc.CallOption) (*ExportCinemaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportCinemaResponse)
+	err := c.cc.Invoke(ctx, CinemaService_ExportCinema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceClient) ImportCinema(ctx context.Context, in *ImportCinemaRequest, opts ...grpc.CallOption) (*ConfigureCinemaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfigureCinemaResponse)
+	err := c.cc.Invoke(ctx, CinemaService_ImportCinema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, CinemaService_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceClient) CreateVenue(ctx context.Context, in *CreateVenueRequest, opts ...grpc.CallOption) (*Venue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Venue)
+	err := c.cc.Invoke(ctx, CinemaService_CreateVenue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceClient) ListVenues(ctx context.Context, in *ListVenuesRequest, opts ...grpc.CallOption) (*ListVenuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVenuesResponse)
+	err := c.cc.Invoke(ctx, CinemaService_ListVenues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceClient) DeleteVenue(ctx context.Context, in *DeleteVenueRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessResponse)
+	err := c.cc.Invoke(ctx, CinemaService_DeleteVenue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, CinemaService_CreateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, CinemaService_ListWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessResponse)
+	err := c.cc.Invoke(ctx, CinemaService_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, CinemaService_ListWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceClient) RetryWebhookDelivery(ctx context.Context, in *RetryWebhookDeliveryRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessResponse)
+	err := c.cc.Invoke(ctx, CinemaService_RetryWebhookDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cinemaServiceClient) GetAvailableSeats(ctx context.Context, in *GetAvailableSeatsRequest, opts ...grpc.CallOption) (*GetAvailableSeatsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetAvailableSeatsResponse)
@@ -82,9 +297,79 @@ func (c *cinemaServiceClient) GetAvailableSeats(ctx context.Context, in *GetAvai
 	return out, nil
 }
 
-func (c *cinemaServiceClient) ReserveSeats(ctx context.Context, in *ReserveSeatsRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
+func (c *cinemaServiceClient) GetSeatMap(ctx context.Context, in *GetSeatMapRequest, opts ...grpc.CallOption) (*GetSeatMapResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SuccessResponse)
+	out := new(GetSeatMapResponse)
+	err := c.cc.Invoke(ctx, CinemaService_GetSeatMap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceClient) ExplainSeat(ctx context.Context, in *ExplainSeatRequest, opts ...grpc.CallOption) (*ExplainSeatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExplainSeatResponse)
+	err := c.cc.Invoke(ctx, CinemaService_ExplainSeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceClient) ListDistancingPolicies(ctx context.Context, in *ListDistancingPoliciesRequest, opts ...grpc.CallOption) (*ListDistancingPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDistancingPoliciesResponse)
+	err := c.cc.Invoke(ctx, CinemaService_ListDistancingPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceClient) GetPolicyViolations(ctx context.Context, in *GetPolicyViolationsRequest, opts ...grpc.CallOption) (*GetPolicyViolationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPolicyViolationsResponse)
+	err := c.cc.Invoke(ctx, CinemaService_GetPolicyViolations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceClient) ListCinemaVersions(ctx context.Context, in *ListCinemaVersionsRequest, opts ...grpc.CallOption) (*ListCinemaVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCinemaVersionsResponse)
+	err := c.cc.Invoke(ctx, CinemaService_ListCinemaVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceClient) DiffCinema(ctx context.Context, in *DiffCinemaRequest, opts ...grpc.CallOption) (*DiffCinemaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffCinemaResponse)
+	err := c.cc.Invoke(ctx, CinemaService_DiffCinema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceClient) RevertCinema(ctx context.Context, in *RevertCinemaRequest, opts ...grpc.CallOption) (*RevertCinemaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevertCinemaResponse)
+	err := c.cc.Invoke(ctx, CinemaService_RevertCinema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceClient) ReserveSeats(ctx context.Context, in *ReserveSeatsRequest, opts ...grpc.CallOption) (*ReserveSeatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveSeatsResponse)
 	err := c.cc.Invoke(ctx, CinemaService_ReserveSeats_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -102,6 +387,106 @@ func (c *cinemaServiceClient) CancelSeats(ctx context.Context, in *CancelSeatsRe
 	return out, nil
 }
 
+func (c *cinemaServiceClient) JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*WaitlistPositionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WaitlistPositionResponse)
+	err := c.cc.Invoke(ctx, CinemaService_JoinWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceClient) LeaveWaitlist(ctx context.Context, in *WaitlistGroupRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessResponse)
+	err := c.cc.Invoke(ctx, CinemaService_LeaveWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceClient) GetWaitlistPosition(ctx context.Context, in *WaitlistGroupRequest, opts ...grpc.CallOption) (*WaitlistPositionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WaitlistPositionResponse)
+	err := c.cc.Invoke(ctx, CinemaService_GetWaitlistPosition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceClient) GetWaitlist(ctx context.Context, in *GetWaitlistRequest, opts ...grpc.CallOption) (*GetWaitlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWaitlistResponse)
+	err := c.cc.Invoke(ctx, CinemaService_GetWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceClient) ConfirmHold(ctx context.Context, in *WaitlistGroupRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessResponse)
+	err := c.cc.Invoke(ctx, CinemaService_ConfirmHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceClient) ReleaseHold(ctx context.Context, in *WaitlistGroupRequest, opts ...grpc.CallOption) (*SuccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuccessResponse)
+	err := c.cc.Invoke(ctx, CinemaService_ReleaseHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceClient) BatchReserve(ctx context.Context, in *BatchReserveRequest, opts ...grpc.CallOption) (*BatchReserveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchReserveResponse)
+	err := c.cc.Invoke(ctx, CinemaService_BatchReserve_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceClient) PlanSeating(ctx context.Context, in *PlanSeatingRequest, opts ...grpc.CallOption) (*PlanSeatingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlanSeatingResponse)
+	err := c.cc.Invoke(ctx, CinemaService_PlanSeating_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceClient) AnalyzeCapacity(ctx context.Context, in *AnalyzeCapacityRequest, opts ...grpc.CallOption) (*AnalyzeCapacityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnalyzeCapacityResponse)
+	err := c.cc.Invoke(ctx, CinemaService_AnalyzeCapacity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceClient) SuggestSeats(ctx context.Context, in *SuggestSeatsRequest, opts ...grpc.CallOption) (*SuggestSeatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestSeatsResponse)
+	err := c.cc.Invoke(ctx, CinemaService_SuggestSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CinemaServiceServer is the server API for CinemaService service.
 // All implementations must embed UnimplementedCinemaServiceServer
 // for forward compatibility.
@@ -112,12 +497,67 @@ type CinemaServiceServer interface {
 	ConfigureCinema(context.Context, *ConfigureCinemaRequest) (*ConfigureCinemaResponse, error)
 	// Configures cinema size and minimum distance between groups
 	UpdateCinemaConfig(context.Context, *UpdateCinemaConfigRequest) (*SuccessResponse, error)
+	// Lists the cinemas of the organization of the caller, page by page
+	ListCinemas(context.Context, *ListCinemasRequest) (*ListCinemasResponse, error)
+	// Deletes a cinema along with its history, cinemas with reservations are only deleted when forced
+	DeleteCinema(context.Context, *DeleteCinemaRequest) (*SuccessResponse, error)
+	// Exports the layout of a cinema, and optionally its reservations
+	ExportCinema(context.Context, *ExportCinemaRequest) (*ExportCinemaResponse, error)
+	// Creates a cinema from an exported layout
+	ImportCinema(context.Context, *ImportCinemaRequest) (*ConfigureCinemaResponse, error)
+	// Lists the recorded state-changing operations, oldest first, for staff only
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	// Creates a venue of the organization of the caller, its halls are cinemas
+	CreateVenue(context.Context, *CreateVenueRequest) (*Venue, error)
+	// Lists the venues of the organization of the caller with their halls
+	ListVenues(context.Context, *ListVenuesRequest) (*ListVenuesResponse, error)
+	// Deletes a venue without halls
+	DeleteVenue(context.Context, *DeleteVenueRequest) (*SuccessResponse, error)
+	// Subscribes a URL to cinema events, payloads are signed with the secret
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*Webhook, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*SuccessResponse, error)
+	// Lists the deliveries of webhooks, oldest first, dead ones form the dead-letter list
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	// Sends a dead delivery again
+	RetryWebhookDelivery(context.Context, *RetryWebhookDeliveryRequest) (*SuccessResponse, error)
 	// Queries available seats that can be purchased together
 	GetAvailableSeats(context.Context, *GetAvailableSeatsRequest) (*GetAvailableSeatsResponse, error)
+	// Queries the state of every seat, row by row
+	GetSeatMap(context.Context, *GetSeatMapRequest) (*GetSeatMapResponse, error)
+	// Tells whether a group can reserve a seat and every reason why not
+	ExplainSeat(context.Context, *ExplainSeatRequest) (*ExplainSeatResponse, error)
+	// Lists the scheduled distancing policies of a cinema
+	ListDistancingPolicies(context.Context, *ListDistancingPoliciesRequest) (*ListDistancingPoliciesResponse, error)
+	// Lists the reservations which would break a scheduled distancing policy, the next one by default
+	GetPolicyViolations(context.Context, *GetPolicyViolationsRequest) (*GetPolicyViolationsResponse, error)
+	// Lists the stored versions of a cinema, every event of the cinema creates a version
+	ListCinemaVersions(context.Context, *ListCinemaVersionsRequest) (*ListCinemaVersionsResponse, error)
+	// Lists the seats whose reservation changed between two points in time
+	DiffCinema(context.Context, *DiffCinemaRequest) (*DiffCinemaResponse, error)
+	// Restores the reservations of a previous version which have been cancelled since
+	RevertCinema(context.Context, *RevertCinemaRequest) (*RevertCinemaResponse, error)
 	// Reserves specific seats by their (row, column) coordinates
-	ReserveSeats(context.Context, *ReserveSeatsRequest) (*SuccessResponse, error)
+	ReserveSeats(context.Context, *ReserveSeatsRequest) (*ReserveSeatsResponse, error)
 	// Cancels reservation of specific seats by their (row, column) coordinates
 	CancelSeats(context.Context, *CancelSeatsRequest) (*SuccessResponse, error)
+	// Adds a party to the waitlist of a cinema, seats freed by cancellations are then
+	// held for it or offered to it in waitlist order
+	JoinWaitlist(context.Context, *JoinWaitlistRequest) (*WaitlistPositionResponse, error)
+	LeaveWaitlist(context.Context, *WaitlistGroupRequest) (*SuccessResponse, error)
+	GetWaitlistPosition(context.Context, *WaitlistGroupRequest) (*WaitlistPositionResponse, error)
+	GetWaitlist(context.Context, *GetWaitlistRequest) (*GetWaitlistResponse, error)
+	// Turns the seats held for a waitlisted party into a reservation
+	ConfirmHold(context.Context, *WaitlistGroupRequest) (*SuccessResponse, error)
+	ReleaseHold(context.Context, *WaitlistGroupRequest) (*SuccessResponse, error)
+	// Reserves seats for several groups at once, either all of them or none
+	BatchReserve(context.Context, *BatchReserveRequest) (*BatchReserveResponse, error)
+	// Plans seats for a list of parties, maximizing the people or parties seated
+	PlanSeating(context.Context, *PlanSeatingRequest) (*PlanSeatingResponse, error)
+	// Computes how many people fit in the cinema under hypothetical minimum distances
+	AnalyzeCapacity(context.Context, *AnalyzeCapacityRequest) (*AnalyzeCapacityResponse, error)
+	// Suggests seats a group can reserve together, without reserving them
+	SuggestSeats(context.Context, *SuggestSeatsRequest) (*SuggestSeatsResponse, error)
 	mustEmbedUnimplementedCinemaServiceServer()
 }
 
@@ -134,15 +574,105 @@ func (UnimplementedCinemaServiceServer) ConfigureCinema(context.Context, *Config
 func (UnimplementedCinemaServiceServer) UpdateCinemaConfig(context.Context, *UpdateCinemaConfigRequest) (*SuccessResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCinemaConfig not implemented")
 }
+func (UnimplementedCinemaServiceServer) ListCinemas(context.Context, *ListCinemasRequest) (*ListCinemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCinemas not implemented")
+}
+func (UnimplementedCinemaServiceServer) DeleteCinema(context.Context, *DeleteCinemaRequest) (*SuccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCinema not implemented")
+}
+func (UnimplementedCinemaServiceServer) ExportCinema(context.Context, *ExportCinemaRequest) (*ExportCinemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportCinema not implemented")
+}
+func (UnimplementedCinemaServiceServer) ImportCinema(context.Context, *ImportCinemaRequest) (*ConfigureCinemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportCinema not implemented")
+}
+func (UnimplementedCinemaServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedCinemaServiceServer) CreateVenue(context.Context, *CreateVenueRequest) (*Venue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVenue not implemented")
+}
+func (UnimplementedCinemaServiceServer) ListVenues(context.Context, *ListVenuesRequest) (*ListVenuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVenues not implemented")
+}
+func (UnimplementedCinemaServiceServer) DeleteVenue(context.Context, *DeleteVenueRequest) (*SuccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVenue not implemented")
+}
+func (UnimplementedCinemaServiceServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedCinemaServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedCinemaServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*SuccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedCinemaServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedCinemaServiceServer) RetryWebhookDelivery(context.Context, *RetryWebhookDeliveryRequest) (*SuccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryWebhookDelivery not implemented")
+}
 func (UnimplementedCinemaServiceServer) GetAvailableSeats(context.Context, *GetAvailableSeatsRequest) (*GetAvailableSeatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAvailableSeats not implemented")
 }
-func (UnimplementedCinemaServiceServer) ReserveSeats(context.Context, *ReserveSeatsRequest) (*SuccessResponse, error) {
+func (UnimplementedCinemaServiceServer) GetSeatMap(context.Context, *GetSeatMapRequest) (*GetSeatMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeatMap not implemented")
+}
+func (UnimplementedCinemaServiceServer) ExplainSeat(context.Context, *ExplainSeatRequest) (*ExplainSeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainSeat not implemented")
+}
+func (UnimplementedCinemaServiceServer) ListDistancingPolicies(context.Context, *ListDistancingPoliciesRequest) (*ListDistancingPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDistancingPolicies not implemented")
+}
+func (UnimplementedCinemaServiceServer) GetPolicyViolations(context.Context, *GetPolicyViolationsRequest) (*GetPolicyViolationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPolicyViolations not implemented")
+}
+func (UnimplementedCinemaServiceServer) ListCinemaVersions(context.Context, *ListCinemaVersionsRequest) (*ListCinemaVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCinemaVersions not implemented")
+}
+func (UnimplementedCinemaServiceServer) DiffCinema(context.Context, *DiffCinemaRequest) (*DiffCinemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffCinema not implemented")
+}
+func (UnimplementedCinemaServiceServer) RevertCinema(context.Context, *RevertCinemaRequest) (*RevertCinemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertCinema not implemented")
+}
+func (UnimplementedCinemaServiceServer) ReserveSeats(context.Context, *ReserveSeatsRequest) (*ReserveSeatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReserveSeats not implemented")
 }
 func (UnimplementedCinemaServiceServer) CancelSeats(context.Context, *CancelSeatsRequest) (*SuccessResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelSeats not implemented")
 }
+func (UnimplementedCinemaServiceServer) JoinWaitlist(context.Context, *JoinWaitlistRequest) (*WaitlistPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinWaitlist not implemented")
+}
+func (UnimplementedCinemaServiceServer) LeaveWaitlist(context.Context, *WaitlistGroupRequest) (*SuccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveWaitlist not implemented")
+}
+func (UnimplementedCinemaServiceServer) GetWaitlistPosition(context.Context, *WaitlistGroupRequest) (*WaitlistPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWaitlistPosition not implemented")
+}
+func (UnimplementedCinemaServiceServer) GetWaitlist(context.Context, *GetWaitlistRequest) (*GetWaitlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWaitlist not implemented")
+}
+func (UnimplementedCinemaServiceServer) ConfirmHold(context.Context, *WaitlistGroupRequest) (*SuccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmHold not implemented")
+}
+func (UnimplementedCinemaServiceServer) ReleaseHold(context.Context, *WaitlistGroupRequest) (*SuccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseHold not implemented")
+}
+func (UnimplementedCinemaServiceServer) BatchReserve(context.Context, *BatchReserveRequest) (*BatchReserveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchReserve not implemented")
+}
+func (UnimplementedCinemaServiceServer) PlanSeating(context.Context, *PlanSeatingRequest) (*PlanSeatingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanSeating not implemented")
+}
+func (UnimplementedCinemaServiceServer) AnalyzeCapacity(context.Context, *AnalyzeCapacityRequest) (*AnalyzeCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyzeCapacity not implemented")
+}
+func (UnimplementedCinemaServiceServer) SuggestSeats(context.Context, *SuggestSeatsRequest) (*SuggestSeatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestSeats not implemented")
+}
 func (UnimplementedCinemaServiceServer) mustEmbedUnimplementedCinemaServiceServer() {}
 func (UnimplementedCinemaServiceServer) testEmbeddedByValue()                       {}
 
@@ -200,26 +730,386 @@ func _CinemaService_UpdateCinemaConfig_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CinemaService_GetAvailableSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAvailableSeatsRequest)
+func _CinemaService_ListCinemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCinemasRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CinemaServiceServer).GetAvailableSeats(ctx, in)
+		return srv.(CinemaServiceServer).ListCinemas(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CinemaService_GetAvailableSeats_FullMethodName,
+		FullMethod: CinemaService_ListCinemas_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CinemaServiceServer).GetAvailableSeats(ctx, req.(*GetAvailableSeatsRequest))
+		return srv.(CinemaServiceServer).ListCinemas(ctx, req.(*ListCinemasRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CinemaService_ReserveSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReserveSeatsRequest)
+func _CinemaService_DeleteCinema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCinemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).DeleteCinema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_DeleteCinema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).DeleteCinema(ctx, req.(*DeleteCinemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_ExportCinema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCinemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).ExportCinema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_ExportCinema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).ExportCinema(ctx, req.(*ExportCinemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_ImportCinema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportCinemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).ImportCinema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_ImportCinema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).ImportCinema(ctx, req.(*ImportCinemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_CreateVenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).CreateVenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_CreateVenue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).CreateVenue(ctx, req.(*CreateVenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_ListVenues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVenuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).ListVenues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_ListVenues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).ListVenues(ctx, req.(*ListVenuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_DeleteVenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).DeleteVenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_DeleteVenue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).DeleteVenue(ctx, req.(*DeleteVenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_ListWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_ListWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_RetryWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryWebhookDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).RetryWebhookDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_RetryWebhookDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).RetryWebhookDelivery(ctx, req.(*RetryWebhookDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_GetAvailableSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailableSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).GetAvailableSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_GetAvailableSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).GetAvailableSeats(ctx, req.(*GetAvailableSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_GetSeatMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeatMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).GetSeatMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_GetSeatMap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).GetSeatMap(ctx, req.(*GetSeatMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_ExplainSeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainSeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).ExplainSeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_ExplainSeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).ExplainSeat(ctx, req.(*ExplainSeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_ListDistancingPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDistancingPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).ListDistancingPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_ListDistancingPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).ListDistancingPolicies(ctx, req.(*ListDistancingPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_GetPolicyViolations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPolicyViolationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).GetPolicyViolations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_GetPolicyViolations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).GetPolicyViolations(ctx, req.(*GetPolicyViolationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_ListCinemaVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCinemaVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).ListCinemaVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_ListCinemaVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).ListCinemaVersions(ctx, req.(*ListCinemaVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_DiffCinema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffCinemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).DiffCinema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_DiffCinema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).DiffCinema(ctx, req.(*DiffCinemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_RevertCinema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertCinemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).RevertCinema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_RevertCinema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).RevertCinema(ctx, req.(*RevertCinemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_ReserveSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveSeatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -254,6 +1144,186 @@ func _CinemaService_CancelSeats_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CinemaService_JoinWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).JoinWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_JoinWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).JoinWaitlist(ctx, req.(*JoinWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_LeaveWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitlistGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).LeaveWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_LeaveWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).LeaveWaitlist(ctx, req.(*WaitlistGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_GetWaitlistPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitlistGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).GetWaitlistPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_GetWaitlistPosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).GetWaitlistPosition(ctx, req.(*WaitlistGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_GetWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).GetWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_GetWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).GetWaitlist(ctx, req.(*GetWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_ConfirmHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitlistGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).ConfirmHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_ConfirmHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).ConfirmHold(ctx, req.(*WaitlistGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_ReleaseHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitlistGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).ReleaseHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_ReleaseHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).ReleaseHold(ctx, req.(*WaitlistGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_BatchReserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchReserveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).BatchReserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_BatchReserve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).BatchReserve(ctx, req.(*BatchReserveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_PlanSeating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanSeatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).PlanSeating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_PlanSeating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).PlanSeating(ctx, req.(*PlanSeatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_AnalyzeCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).AnalyzeCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_AnalyzeCapacity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).AnalyzeCapacity(ctx, req.(*AnalyzeCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaService_SuggestSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceServer).SuggestSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CinemaService_SuggestSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceServer).SuggestSeats(ctx, req.(*SuggestSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CinemaService_ServiceDesc is the grpc.ServiceDesc for CinemaService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -269,10 +1339,90 @@ var CinemaService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCinemaConfig",
 			Handler:    _CinemaService_UpdateCinemaConfig_Handler,
 		},
+		{
+			MethodName: "ListCinemas",
+			Handler:    _CinemaService_ListCinemas_Handler,
+		},
+		{
+			MethodName: "DeleteCinema",
+			Handler:    _CinemaService_DeleteCinema_Handler,
+		},
+		{
+			MethodName: "ExportCinema",
+			Handler:    _CinemaService_ExportCinema_Handler,
+		},
+		{
+			MethodName: "ImportCinema",
+			Handler:    _CinemaService_ImportCinema_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _CinemaService_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "CreateVenue",
+			Handler:    _CinemaService_CreateVenue_Handler,
+		},
+		{
+			MethodName: "ListVenues",
+			Handler:    _CinemaService_ListVenues_Handler,
+		},
+		{
+			MethodName: "DeleteVenue",
+			Handler:    _CinemaService_DeleteVenue_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _CinemaService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _CinemaService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _CinemaService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _CinemaService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "RetryWebhookDelivery",
+			Handler:    _CinemaService_RetryWebhookDelivery_Handler,
+		},
 		{
 			MethodName: "GetAvailableSeats",
 			Handler:    _CinemaService_GetAvailableSeats_Handler,
 		},
+		{
+			MethodName: "GetSeatMap",
+			Handler:    _CinemaService_GetSeatMap_Handler,
+		},
+		{
+			MethodName: "ExplainSeat",
+			Handler:    _CinemaService_ExplainSeat_Handler,
+		},
+		{
+			MethodName: "ListDistancingPolicies",
+			Handler:    _CinemaService_ListDistancingPolicies_Handler,
+		},
+		{
+			MethodName: "GetPolicyViolations",
+			Handler:    _CinemaService_GetPolicyViolations_Handler,
+		},
+		{
+			MethodName: "ListCinemaVersions",
+			Handler:    _CinemaService_ListCinemaVersions_Handler,
+		},
+		{
+			MethodName: "DiffCinema",
+			Handler:    _CinemaService_DiffCinema_Handler,
+		},
+		{
+			MethodName: "RevertCinema",
+			Handler:    _CinemaService_RevertCinema_Handler,
+		},
 		{
 			MethodName: "ReserveSeats",
 			Handler:    _CinemaService_ReserveSeats_Handler,
@@ -281,6 +1431,46 @@ var CinemaService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelSeats",
 			Handler:    _CinemaService_CancelSeats_Handler,
 		},
+		{
+			MethodName: "JoinWaitlist",
+			Handler:    _CinemaService_JoinWaitlist_Handler,
+		},
+		{
+			MethodName: "LeaveWaitlist",
+			Handler:    _CinemaService_LeaveWaitlist_Handler,
+		},
+		{
+			MethodName: "GetWaitlistPosition",
+			Handler:    _CinemaService_GetWaitlistPosition_Handler,
+		},
+		{
+			MethodName: "GetWaitlist",
+			Handler:    _CinemaService_GetWaitlist_Handler,
+		},
+		{
+			MethodName: "ConfirmHold",
+			Handler:    _CinemaService_ConfirmHold_Handler,
+		},
+		{
+			MethodName: "ReleaseHold",
+			Handler:    _CinemaService_ReleaseHold_Handler,
+		},
+		{
+			MethodName: "BatchReserve",
+			Handler:    _CinemaService_BatchReserve_Handler,
+		},
+		{
+			MethodName: "PlanSeating",
+			Handler:    _CinemaService_PlanSeating_Handler,
+		},
+		{
+			MethodName: "AnalyzeCapacity",
+			Handler:    _CinemaService_AnalyzeCapacity_Handler,
+		},
+		{
+			MethodName: "SuggestSeats",
+			Handler:    _CinemaService_SuggestSeats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cinema/cinema.proto",
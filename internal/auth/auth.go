@@ -0,0 +1,101 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/t3201v/seat-arrangement/internal/model"
+)
+
+// MetadataKey carries the api key of the caller as "Bearer <key>", http clients send the
+// Authorization header which the gateway passes on
+const MetadataKey = "authorization"
+
+// RoleStaff lets a caller see the group names of every reservation
+const RoleStaff = "staff"
+
+// Principal is the caller authenticated by an api key
+type Principal struct {
+	Name   string // recorded as the actor in the audit log
+	Tenant string // organization owning the cinemas the caller sees
+	Role   string // RoleStaff or empty for customers
+}
+
+// KeyConfig is an api key as configured, the key itself is not stored
+type KeyConfig struct {
+	SHA256 string `mapstructure:"sha256"` // hex sha256 of the key, see Hash
+	Name   string `mapstructure:"name"`   // of the holder, "key-" and the start of the hash if empty
+	Tenant string `mapstructure:"tenant"`
+	Role   string `mapstructure:"role"`
+}
+
+// Keys authenticates the callers by api key
+type Keys struct {
+	principals map[string]Principal // by hash of the key
+}
+
+// NewKeys checks the configured keys, there must be one at least and every key must belong
+// to a valid tenant
+func NewKeys(configs []KeyConfig) (*Keys, error) {
+	if len(configs) == 0 {
+		return nil, errors.New("no api key configured")
+	}
+	keys := &Keys{principals: make(map[string]Principal, len(configs))}
+	for i, config := range configs {
+		hash := strings.ToLower(config.SHA256)
+		if b, err := hex.DecodeString(hash); err != nil || len(b) != sha256.Size {
+			return nil, fmt.Errorf("api key %d: sha256 must be 64 hex digits", i)
+		}
+		if err := model.ValidateTenant(config.Tenant); err != nil {
+			return nil, fmt.Errorf("api key %d: %w", i, err)
+		}
+		if config.Role != "" && config.Role != RoleStaff {
+			return nil, fmt.Errorf("api key %d: unknown role %q", i, config.Role)
+		}
+		if _, ok := keys.principals[hash]; ok {
+			return nil, fmt.Errorf("api key %d: configured twice", i)
+		}
+		name := config.Name
+		if name == "" {
+			name = "key-" + hash[:8]
+		}
+		keys.principals[hash] = Principal{Name: name, Tenant: config.Tenant, Role: config.Role}
+	}
+	return keys, nil
+}
+
+// Hash returns the hex sha256 of the key, as configured
+func Hash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// Authenticate returns the caller of the "Bearer <key>" credentials
+func (k *Keys) Authenticate(credentials string) (Principal, error) {
+	key, ok := strings.CutPrefix(credentials, "Bearer ")
+	if !ok || key == "" {
+		return Principal{}, errors.New("missing api key")
+	}
+	p, ok := k.principals[Hash(key)]
+	if !ok {
+		return Principal{}, errors.New("unknown api key")
+	}
+	return p, nil
+}
+
+type contextKey struct{}
+
+// NewContext returns a context of the authenticated caller
+func NewContext(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, contextKey{}, p)
+}
+
+// FromContext returns the authenticated caller, if any
+func FromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(contextKey{}).(Principal)
+	return p, ok
+}
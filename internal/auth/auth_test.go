@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"context"
+	"testing"
+)
+
+func TestKeys_Authenticate(t *testing.T) {
+	keys, err := NewKeys([]KeyConfig{
+		{SHA256: Hash("acme-key"), Name: "box-office", Tenant: "acme"},
+		{SHA256: Hash("other-key"), Tenant: "other", Role: RoleStaff},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p, err := keys.Authenticate("Bearer acme-key"); err != nil || p != (Principal{Name: "box-office", Tenant: "acme"}) {
+		t.Errorf("Authenticate(acme-key) = %+v, %v", p, err)
+	}
+	if p, err := keys.Authenticate("Bearer other-key"); err != nil || p != (Principal{Name: "key-" + Hash("other-key")[:8], Tenant: "other", Role: RoleStaff}) {
+		t.Errorf("Authenticate(other-key) = %+v, %v", p, err)
+	}
+	for _, credentials := range []string{"", "Bearer ", "acme-key", "Basic acme-key", "Bearer unknown"} {
+		if p, err := keys.Authenticate(credentials); err == nil {
+			t.Errorf("Authenticate(%q) = %+v", credentials, p)
+		}
+	}
+
+	for _, configs := range [][]KeyConfig{
+		nil,
+		{{SHA256: "short", Tenant: "acme"}},
+		{{SHA256: Hash("key"), Tenant: ""}},
+		{{SHA256: Hash("key"), Tenant: "acme", Role: "admin"}},
+		{{SHA256: Hash("key"), Tenant: "acme"}, {SHA256: Hash("key"), Tenant: "other"}},
+	} {
+		if _, err := NewKeys(configs); err == nil {
+			t.Errorf("NewKeys(%+v) succeeded", configs)
+		}
+	}
+
+	ctx := NewContext(context.Background(), Principal{Tenant: "acme"})
+	if p, ok := FromContext(ctx); !ok || p.Tenant != "acme" {
+		t.Errorf("FromContext() = %+v, %v", p, ok)
+	}
+	if _, ok := FromContext(context.Background()); ok {
+		t.Errorf("FromContext() found a caller in an empty context")
+	}
+}
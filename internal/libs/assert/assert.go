@@ -16,7 +16,7 @@ func NoError(err error, msg ...string) {
 
 func NoErrorf(err error, msg string, args ...any) {
 	if err != nil {
-		log.Fatalf("error: %v reason: %v", err, fmt.Sprintf(msg, args))
+		log.Fatalf("error: %v reason: %v", err, fmt.Sprintf(msg, args...))
 	}
 }
 
@@ -29,6 +29,6 @@ func NotFalse(b bool, msg ...string) {
 
 func NotNilf[T any](d *T, msg string, args ...any) {
 	if d == nil {
-		log.Fatalf("expected not nil %v", fmt.Sprintf(msg, args))
+		log.Fatalf("expected not nil %v", fmt.Sprintf(msg, args...))
 	}
 }
@@ -0,0 +1,94 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// ReserveOptions holds the per request parameters of a reservation
+type ReserveOptions struct {
+	Accessible bool             // the group needs wheelchair spaces
+	At         time.Time        // time of the reservation, now if zero
+	Adjacency  *AdjacencyPolicy // overrides the adjacency policy of the cinema when at least as strict
+}
+
+func (o ReserveOptions) at() time.Time {
+	if o.At.IsZero() {
+		return time.Now()
+	}
+	return o.At
+}
+
+// SetShowTime sets the start of the show and how long before it unsold accessible seats go to general sale
+func (c *Cinema) SetShowTime(showTime time.Time, accessibleRelease time.Duration) error {
+	if accessibleRelease < 0 {
+		return fmt.Errorf("accessible release must not be negative, got %v", accessibleRelease)
+	}
+	c.showTime = showTime
+	c.accessibleRelease = accessibleRelease
+	c.configChanged()
+	return nil
+}
+
+// ShowTime returns the start of the show and the accessible seats release
+func (c *Cinema) ShowTime() (time.Time, time.Duration) {
+	return c.showTime, c.accessibleRelease
+}
+
+// AccessibleReleased reports whether the wheelchair and companion seats are on general sale at the given time
+func (c *Cinema) AccessibleReleased(at time.Time) bool {
+	if c.showTime.IsZero() {
+		return false
+	}
+	return !at.Before(c.showTime.Add(-c.accessibleRelease))
+}
+
+func isAccessible(category SeatCategory) bool {
+	return category == Wheelchair || category == Companion
+}
+
+// checkAccessibility enforces that wheelchair spaces are only booked by groups that need them
+// and that companion seats are only booked along with an adjacent wheelchair space
+func (c *Cinema) checkAccessibility(seatCoords [][]int, groupName string, opts ReserveOptions) error {
+	if c.AccessibleReleased(opts.at()) {
+		return nil
+	}
+
+	requested := make(map[[2]int]bool, len(seatCoords))
+	for _, seat := range seatCoords {
+		requested[[2]int{seat[Row], seat[Col]}] = true
+	}
+	for _, seat := range seatCoords {
+		switch c.SeatCategoryOf(seat[Row], seat[Col]) {
+		case Wheelchair:
+			if !opts.Accessible {
+				return fmt.Errorf("seat (%d, %d) is a wheelchair space reserved for groups that need it", seat[Row], seat[Col])
+			}
+		case Companion:
+			if !c.hasAdjacentWheelchair(seat[Row], seat[Col], groupName, requested) {
+				return fmt.Errorf("companion seat (%d, %d) must be reserved with an adjacent wheelchair space", seat[Row], seat[Col])
+			}
+		}
+	}
+	return nil
+}
+
+// hasAdjacentWheelchair reports whether a wheelchair space next to the seat is requested or already held by the group
+func (c *Cinema) hasAdjacentWheelchair(row, col int, groupName string, requested map[[2]int]bool) bool {
+	for _, d := range directions {
+		i, j := row+d[Row], col+d[Col]
+		if i < 0 || i >= c.rows || j < 0 || j >= c.columns {
+			continue
+		}
+		if c.SeatCategoryOf(i, j) != Wheelchair {
+			continue
+		}
+		if requested[[2]int{i, j}] {
+			return true
+		}
+		if c.seats.at(i, j).status == Reserved && c.seats.at(i, j).groupName == groupName {
+			return true
+		}
+	}
+	return false
+}
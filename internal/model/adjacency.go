@@ -0,0 +1,191 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type Adjacency int
+
+const (
+	AdjacencyAny       Adjacency = iota // seats of a group may be anywhere
+	AdjacencySameRow                    // consecutive seats of a single row
+	AdjacencyConnected                  // every seat next to another one of the group, up, down, left or right
+)
+
+var adjacencyNames = []string{"any", "same_row", "connected"}
+
+func (a Adjacency) String() string {
+	if a < AdjacencyAny || a > AdjacencyConnected {
+		return fmt.Sprintf("Adjacency(%d)", int(a))
+	}
+	return adjacencyNames[a]
+}
+
+// AdjacencyPolicy tells how the seats of a group must sit together, the zero value lets
+// them sit anywhere
+type AdjacencyPolicy struct {
+	Rule       Adjacency
+	AllowSplit bool // seats may be apart when no block of seats following the rule is free
+}
+
+// adjacencyStrictness orders the rules from the loosest, consecutive seats of a row are also connected
+var adjacencyStrictness = map[Adjacency]int{AdjacencyAny: 0, AdjacencyConnected: 1, AdjacencySameRow: 2}
+
+// AtLeastAsStrict reports whether every group following the policy also follows the other one
+func (p AdjacencyPolicy) AtLeastAsStrict(other AdjacencyPolicy) bool {
+	strictness, ok := adjacencyStrictness[p.Rule]
+	if !ok || strictness < adjacencyStrictness[other.Rule] {
+		return false
+	}
+	// splitting is only looser than a rule keeping the group together
+	return !p.AllowSplit || other.AllowSplit || other.Rule == AdjacencyAny
+}
+
+// ErrGroupSplit is wrapped by the errors of reservations breaking the adjacency policy
+var ErrGroupSplit = errors.New("seats of the group are not together")
+
+// SetAdjacencyPolicy changes how the seats of a group must sit together
+func (c *Cinema) SetAdjacencyPolicy(policy AdjacencyPolicy) error {
+	if policy.Rule < AdjacencyAny || policy.Rule > AdjacencyConnected {
+		return fmt.Errorf("unknown adjacency rule %d", policy.Rule)
+	}
+	c.adjacency = policy
+	c.configChanged()
+	return nil
+}
+
+func (p AdjacencyPolicy) String() string {
+	if p.AllowSplit {
+		return p.Rule.String() + " (split allowed)"
+	}
+	return p.Rule.String()
+}
+
+// AdjacencyPolicy returns how the seats of a group must sit together
+func (c *Cinema) AdjacencyPolicy() AdjacencyPolicy {
+	return c.adjacency
+}
+
+// together reports whether the seats follow the adjacency rule
+func together(seatCoords [][]int, rule Adjacency) bool {
+	if len(seatCoords) == 0 || rule == AdjacencyAny {
+		return true
+	}
+	seats := make(map[[2]int]bool, len(seatCoords))
+	for _, seat := range seatCoords {
+		seats[[2]int{seat[Row], seat[Col]}] = true
+	}
+	if rule == AdjacencySameRow {
+		row, first, last := seatCoords[0][Row], seatCoords[0][Col], seatCoords[0][Col]
+		for _, seat := range seatCoords {
+			if seat[Row] != row {
+				return false
+			}
+			first, last = min(first, seat[Col]), max(last, seat[Col])
+		}
+		return last-first+1 == len(seats)
+	}
+
+	// every seat must be reached from the first one
+	start := [2]int{seatCoords[0][Row], seatCoords[0][Col]}
+	visited := map[[2]int]bool{start: true}
+	queue := [][2]int{start}
+	for len(queue) > 0 {
+		seat := queue[0]
+		queue = queue[1:]
+		for _, d := range directions {
+			next := [2]int{seat[Row] + d[Row], seat[Col] + d[Col]}
+			if seats[next] && !visited[next] {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return len(visited) == len(seats)
+}
+
+// groupSeats lists the seats reserved by the group, seats reserved without a group name do
+// not make a group
+func (c *Cinema) groupSeats(groupName string) [][]int {
+	if groupName == "" {
+		return nil
+	}
+	var result [][]int
+	for i := 0; i < c.rows; i++ {
+		for j := 0; j < c.columns; j++ {
+			if seat := c.seats.at(i, j); seat.status == Reserved && seat.groupName == groupName {
+				result = append(result, []int{i, j})
+			}
+		}
+	}
+	return result
+}
+
+// freeBlock looks for a block of seats the group could reserve following the adjacency rule
+// along with the seats it already has
+func (c *Cinema) freeBlock(size int, groupName string, existing [][]int, rule Adjacency, opts ReserveOptions) [][]int {
+	fits := func(candidate [][]int) bool {
+		return len(candidate) == size && c.checkSeats(candidate, groupName, opts) == nil &&
+			together(append(slices.Clone(existing), candidate...), rule)
+	}
+	for i := 0; i < c.rows; i++ {
+		for j := 0; j+size <= c.columns; j++ {
+			candidate := make([][]int, 0, size)
+			for k := j; k < j+size; k++ {
+				candidate = append(candidate, []int{i, k})
+			}
+			if fits(candidate) {
+				return candidate
+			}
+		}
+	}
+	if rule != AdjacencyConnected {
+		return nil
+	}
+	for i := 0; i < c.rows; i++ {
+		for j := 0; j < c.columns; j++ {
+			if candidate := c.growBlock(i, j, size); fits(candidate) {
+				return candidate
+			}
+		}
+	}
+	return nil
+}
+
+// checkAdjacency returns why the seats, along with the ones the group already has, break the
+// adjacency policy, if they do
+func (c *Cinema) checkAdjacency(seatCoords [][]int, groupName string, opts ReserveOptions) error {
+	policy := c.adjacency
+	if opts.Adjacency != nil {
+		if !opts.Adjacency.AtLeastAsStrict(c.adjacency) {
+			return fmt.Errorf("adjacency %s looser than the %s policy of the cinema", opts.Adjacency, c.adjacency)
+		}
+		policy = *opts.Adjacency
+	}
+	if policy.Rule == AdjacencyAny {
+		return nil
+	}
+	existing := c.groupSeats(groupName)
+	if together(append(slices.Clone(existing), seatCoords...), policy.Rule) {
+		return nil
+	}
+	rule := "consecutive seats of a single row"
+	if policy.Rule == AdjacencyConnected {
+		rule = "connected seats"
+	}
+	if !policy.AllowSplit {
+		return fmt.Errorf("%w, they must be %s", ErrGroupSplit, rule)
+	}
+	block := c.freeBlock(len(seatCoords), groupName, existing, policy.Rule, opts)
+	if block == nil {
+		return nil
+	}
+	labels := make([]string, 0, len(block))
+	for _, seat := range block {
+		labels = append(labels, c.SeatLabel(seat[Row], seat[Col]))
+	}
+	return fmt.Errorf("%w, they must be %s while some are free, e.g. %s", ErrGroupSplit, rule, strings.Join(labels, ", "))
+}
@@ -0,0 +1,74 @@
+package model
+
+import (
+	"cmp"
+	"time"
+)
+
+type AuditOperation string
+
+const (
+	OpConfigure     AuditOperation = "configure"
+	OpUpdate        AuditOperation = "update"
+	OpImport        AuditOperation = "import"
+	OpReserve       AuditOperation = "reserve"
+	OpCancel        AuditOperation = "cancel"
+	OpBatchReserve  AuditOperation = "batch_reserve"
+	OpPlanApply     AuditOperation = "plan_apply"
+	OpRevert        AuditOperation = "revert"
+	OpDelete        AuditOperation = "delete"
+	OpWaitlistJoin  AuditOperation = "waitlist_join"
+	OpWaitlistLeave AuditOperation = "waitlist_leave"
+	OpHold          AuditOperation = "hold" // seats held for a waitlisted party by a cancellation
+	OpHoldConfirm   AuditOperation = "hold_confirm"
+	OpHoldRelease   AuditOperation = "hold_release"
+)
+
+// AuditSeat is a seat as given in the request, by coordinates or by label
+type AuditSeat struct {
+	Row    int    `json:"row"`
+	Column int    `json:"column"`
+	Label  string `json:"label,omitempty"`
+}
+
+// AuditEvent records a state-changing operation and its outcome
+type AuditEvent struct {
+	RequestID string         `json:"request_id"`
+	Time      time.Time      `json:"time"`
+	Actor     string         `json:"actor"`
+	Tenant    string         `json:"tenant,omitempty"` // events logged before tenants belong to DefaultTenant
+	Operation AuditOperation `json:"operation"`
+	CinemaID  string         `json:"cinema_id,omitempty"`
+	GroupName string         `json:"group_name,omitempty"`
+	Seats     []AuditSeat    `json:"seats,omitempty"`
+	Success   bool           `json:"success"`
+	Error     string         `json:"error,omitempty"`
+}
+
+// AuditFilter selects audit events, zero fields match every event
+type AuditFilter struct {
+	Tenant   string
+	CinemaID string
+	Actor    string
+	Since    time.Time // inclusive
+	Until    time.Time // exclusive
+}
+
+func (f AuditFilter) Match(e AuditEvent) bool {
+	if f.Tenant != "" && cmp.Or(e.Tenant, DefaultTenant) != f.Tenant {
+		return false
+	}
+	if f.CinemaID != "" && e.CinemaID != f.CinemaID {
+		return false
+	}
+	if f.Actor != "" && e.Actor != f.Actor {
+		return false
+	}
+	if !f.Since.IsZero() && e.Time.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && !e.Time.Before(f.Until) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,76 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+)
+
+// GroupReservation is one group of a batch reservation
+type GroupReservation struct {
+	GroupName  string
+	SeatCoords [][]int
+	Options    ReserveOptions
+}
+
+// ErrBatchRejected is returned when at least one group of a batch cannot be reserved
+var ErrBatchRejected = errors.New("batch reservation rejected")
+
+// BatchReserve reserves the seats of every group or none of them. Groups are validated
+// against the existing reservations and against each other. On failure it returns
+// ErrBatchRejected along with the error of each group, nil for the groups that were fine.
+func (c *Cinema) BatchReserve(reservations []GroupReservation) ([]error, error) {
+	if len(reservations) == 0 {
+		return nil, errors.New("no group to reserve")
+	}
+	errs := make([]error, len(reservations))
+	failed := false
+	for i, r := range reservations {
+		if err := c.checkReservation(r.SeatCoords, r.GroupName, r.Options); err != nil {
+			errs[i] = err
+			failed = true
+		}
+	}
+	for i := range reservations {
+		for j := i + 1; j < len(reservations); j++ {
+			if err := c.checkBatchPair(reservations[i], reservations[j]); err != nil {
+				if errs[i] == nil {
+					errs[i] = fmt.Errorf("group %q: %w", reservations[j].GroupName, err)
+				}
+				if errs[j] == nil {
+					errs[j] = fmt.Errorf("group %q: %w", reservations[i].GroupName, err)
+				}
+				failed = true
+			}
+		}
+	}
+	if failed {
+		return errs, ErrBatchRejected
+	}
+
+	for _, r := range reservations {
+		for _, seat := range r.SeatCoords {
+			c.seats.reserve(seat[Row], seat[Col], r.GroupName)
+		}
+		c.emit(SeatsReserved{GroupName: r.GroupName, SeatCoords: r.SeatCoords})
+		c.leaveWaitlistOnReservation(r.GroupName)
+	}
+	return errs, nil
+}
+
+// checkBatchPair checks two groups of the same batch do not overlap nor break the distance rule
+func (c *Cinema) checkBatchPair(a, b GroupReservation) error {
+	for _, seatA := range a.SeatCoords {
+		for _, seatB := range b.SeatCoords {
+			if seatA[Row] == seatB[Row] && seatA[Col] == seatB[Col] {
+				return fmt.Errorf("seat (%d, %d) requested twice", seatA[Row], seatA[Col])
+			}
+			if a.GroupName == b.GroupName {
+				continue
+			}
+			if c.tooClose(seatA[Row], seatA[Col], seatB[Row], seatB[Col]) {
+				return fmt.Errorf("seats (%d, %d) and (%d, %d) are too close", seatA[Row], seatA[Col], seatB[Row], seatB[Col])
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/t3201v/seat-arrangement/internal/libs/util"
+)
+
+// GroupCapacity is how many groups of a size fit in the cinema
+type GroupCapacity struct {
+	GroupSize       int
+	MaxGroups       int // in the empty cinema
+	RemainingGroups int // next to the current reservations
+}
+
+// CapacityScenario is the capacity of the cinema under a minimum distance
+type CapacityScenario struct {
+	MinDistance    int
+	MaxSeats       int // single seats in the empty cinema
+	RemainingSeats int // single seats next to the current reservations
+	DeadSeats      int // free seats no new group can reserve
+	Groups         []GroupCapacity
+}
+
+// CapacityReport is the result of AnalyzeCapacity
+type CapacityReport struct {
+	TotalSeats    int
+	ReservedSeats int
+	Scenarios     []CapacityScenario
+}
+
+// Occupancy returns the reserved seats over the total seats
+func (r *CapacityReport) Occupancy() float64 {
+	if r.TotalSeats == 0 {
+		return 0
+	}
+	return float64(r.ReservedSeats) / float64(r.TotalSeats)
+}
+
+// AnalyzeCapacity computes the capacity of the cinema for every minimum distance. Groups are
+// packed row by row from the front, which is optimal for single seats and a close lower
+// bound for larger groups. Accessibility rules are ignored.
+func (c *Cinema) AnalyzeCapacity(minDistances []int, groupSizes []int) (*CapacityReport, error) {
+	if len(minDistances) == 0 {
+		minDistances = []int{c.minDistance}
+	}
+	for _, d := range minDistances {
+		if d < 0 {
+			return nil, fmt.Errorf("min distance must not be negative, got %d", d)
+		}
+	}
+	for _, size := range groupSizes {
+		if size <= 0 {
+			return nil, fmt.Errorf("group size must be positive, got %d", size)
+		}
+	}
+
+	report := &CapacityReport{
+		TotalSeats: c.rows*c.columns - len(c.unsellable),
+		Scenarios:  make([]CapacityScenario, 0, len(minDistances)),
+	}
+	for i := 0; i < c.rows; i++ {
+		for j := 0; j < c.columns; j++ {
+			if c.seats.at(i, j).status == Reserved {
+				report.ReservedSeats++
+			}
+		}
+	}
+
+	for _, d := range minDistances {
+		empty := c.newBlockedGrid(d, false)
+		current := c.newBlockedGrid(d, true)
+		scenario := CapacityScenario{
+			MinDistance:    d,
+			MaxSeats:       c.packGroups(1, d, empty),
+			RemainingSeats: c.packGroups(1, d, current),
+			Groups:         make([]GroupCapacity, 0, len(groupSizes)),
+		}
+		for i := 0; i < c.rows; i++ {
+			for j := 0; j < c.columns; j++ {
+				if c.seats.at(i, j).status == Available && !c.IsUnsellable(i, j) && current[i][j] {
+					scenario.DeadSeats++
+				}
+			}
+		}
+		for _, size := range groupSizes {
+			scenario.Groups = append(scenario.Groups, GroupCapacity{
+				GroupSize:       size,
+				MaxGroups:       c.packGroups(size, d, empty),
+				RemainingGroups: c.packGroups(size, d, current),
+			})
+		}
+		report.Scenarios = append(report.Scenarios, scenario)
+	}
+	return report, nil
+}
+
+// newBlockedGrid marks the seats a new group cannot take, taking the current reservations into account if asked
+func (c *Cinema) newBlockedGrid(minDistance int, withReservations bool) [][]bool {
+	blocked := make([][]bool, c.rows)
+	for i := range blocked {
+		blocked[i] = make([]bool, c.columns)
+	}
+	for coord := range c.unsellable {
+		blocked[coord[Row]][coord[Col]] = true
+	}
+	if !withReservations {
+		return blocked
+	}
+	for i := 0; i < c.rows; i++ {
+		for j := 0; j < c.columns; j++ {
+			if c.seats.at(i, j).status == Reserved {
+				c.blockAround(blocked, i, j, minDistance)
+			}
+		}
+	}
+	return blocked
+}
+
+// blockAround marks every seat within the distance of (row, col)
+func (c *Cinema) blockAround(blocked [][]bool, row, col, distance int) {
+	for i := max(0, row-distance); i <= min(c.rows-1, row+distance); i++ {
+		rest := distance - util.Abs(i-row)
+		for j := max(0, col-rest); j <= min(c.columns-1, col+rest); j++ {
+			blocked[i][j] = true
+		}
+	}
+}
+
+// packGroups counts the groups of the size that fit in consecutive seats of a row, it does not modify blocked
+func (c *Cinema) packGroups(size, minDistance int, blocked [][]bool) int {
+	grid := make([][]bool, len(blocked))
+	for i := range blocked {
+		grid[i] = append([]bool(nil), blocked[i]...)
+	}
+	count := 0
+	for i := 0; i < c.rows; i++ {
+		for j := 0; j+size <= c.columns; {
+			free := true
+			for k := j; k < j+size; k++ {
+				if grid[i][k] {
+					free = false
+					j = k + 1
+					break
+				}
+			}
+			if !free {
+				continue
+			}
+			for k := j; k < j+size; k++ {
+				c.blockAround(grid, i, k, minDistance)
+			}
+			count++
+			j += size
+		}
+	}
+	return count
+}
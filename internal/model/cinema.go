@@ -3,11 +3,13 @@ package model
 import (
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/t3201v/seat-arrangement/gen/cinema"
-	"github.com/t3201v/seat-arrangement/internal/helper"
 )
 
 type SeatStatus int
@@ -18,9 +20,24 @@ const (
 	SeatStatusEnd
 )
 
+type SeatCategory int
+
+const (
+	Standard SeatCategory = iota
+	Premium
+	VIP
+	Wheelchair
+	Companion
+	SeatCategoryEnd
+)
+
+// categoryCodes are the letters used to render categories in the grid
+var categoryCodes = [SeatCategoryEnd]byte{'S', 'P', 'V', 'W', 'C'}
+
 const (
 	Row int = iota
 	Col
+	Cat // optional category of a seat in listings
 )
 
 var directions = [][]int{
@@ -30,47 +47,114 @@ var directions = [][]int{
 	{0, 1},  // right
 }
 
+// Seat is the state of a seat as read from the grid
 type Seat struct {
 	status    SeatStatus
 	groupName string
 }
 
+// SeatPrice is the price of a single seat
+type SeatPrice struct {
+	Row      int
+	Col      int
+	Label    string
+	Category SeatCategory
+	Price    int64
+}
+
+// PriceBreakdown lists the price of every seat of a reservation
+type PriceBreakdown struct {
+	Currency string
+	Items    []SeatPrice
+	Total    int64
+}
+
 // Cinema structure to hold seat information and minimum distance rule
 type Cinema struct {
 	logger      *log.Logger
+	name        string
+	description string
 	rows        int
 	columns     int
 	minDistance int
-	seats       [][]Seat // 0: available, 1: reserved
+	separation  SeparationProfile // replaces minDistance when set
+	seats       seatGrid
+	categories  map[[2]int]SeatCategory // seats not in the map are standard
+	unsellable  map[[2]int]bool
+	labels      LabelScheme
+	adjacency   AdjacencyPolicy
+	currency    string
+	prices      map[SeatCategory]int64
+
+	showTime          time.Time
+	accessibleRelease time.Duration // unsold accessible seats go to general sale this long before the show
+
+	policies      []DistancingPolicy // sorted by effective time
+	policyApplied time.Time          // effective time of the last policy applied
+
+	waitlist []WaitlistEntry
+	holds    map[string]Hold // seats held for waitlisted parties by group name
+
+	events      []Event // emitted since the last TakeEvents
+	configured  bool    // CinemaConfigured has been emitted
+	configDirty bool    // the setup changed since the last emitted event
 }
 
-// NewCinema initializes the cinema layout with the given rows, columns, and min_distance
-func NewCinema(l *log.Logger, rows, columns, minDistance int) *Cinema {
-	seats := make([][]Seat, rows)
-	for i := range seats {
-		seats[i] = make([]Seat, columns)
+// Bounds of the size of a cinema, see ValidateConfig
+const (
+	MaxRows    = 500
+	MaxColumns = 500
+)
+
+// ValidateConfig checks the size and the min distance of a cinema: 1 to MaxRows rows,
+// 1 to MaxColumns columns and a min distance from 0 to MaxRows+MaxColumns
+func ValidateConfig(rows, columns, minDistance int) error {
+	if rows <= 0 || rows > MaxRows {
+		return fmt.Errorf("rows must be in range [1, %d], got %d", MaxRows, rows)
+	}
+	if columns <= 0 || columns > MaxColumns {
+		return fmt.Errorf("columns must be in range [1, %d], got %d", MaxColumns, columns)
 	}
+	if minDistance < 0 || minDistance > MaxRows+MaxColumns {
+		return fmt.Errorf("min distance must be in range [0, %d], got %d", MaxRows+MaxColumns, minDistance)
+	}
+	return nil
+}
+
+// NewCinema initializes the cinema layout with the given rows, columns, and min_distance,
+// which the caller checks with ValidateConfig
+func NewCinema(l *log.Logger, rows, columns, minDistance int) *Cinema {
 	return &Cinema{
 		logger:      l,
 		rows:        rows,
 		columns:     columns,
 		minDistance: minDistance,
-		seats:       seats,
+		seats:       newSeatGrid(rows, columns),
+		categories:  make(map[[2]int]SeatCategory),
+		unsellable:  make(map[[2]int]bool),
+		prices:      make(map[SeatCategory]int64),
+		holds:       make(map[string]Hold),
+		configDirty: true,
 	}
 }
 
+// Size returns the number of rows and columns
+func (c *Cinema) Size() (int, int) {
+	return c.rows, c.columns
+}
+
 func (c *Cinema) validate(seatCoords [][]int) error {
-	if len(c.seats) == 0 || len(c.seats[0]) == 0 {
+	if c.seats.empty() {
 		return errors.New("malformed seats data")
 	}
 	for _, seat := range seatCoords {
 		if len(seat) != 2 {
 			return errors.New("seat coordinates must be of length 2")
 		}
-		if seat[Row] < 0 || seat[Row] >= len(c.seats) {
+		if seat[Row] < 0 || seat[Row] >= c.seats.rows {
 			return fmt.Errorf("seat coordinates must be in range [%d, %d)", Row, c.rows)
 		}
-		if seat[Col] < 0 || seat[Col] >= len(c.seats[Row]) {
+		if seat[Col] < 0 || seat[Col] >= c.seats.columns {
 			return fmt.Errorf("seat coordinates must be in range [%d, %d)", Col, c.columns)
 		}
 	}
@@ -78,16 +162,132 @@ func (c *Cinema) validate(seatCoords [][]int) error {
 }
 
 // it will reset seats if rows or columns number's changed
-func (c *Cinema) UpdateConfig(rows, columns, minDistance int) {
+func (c *Cinema) UpdateConfig(rows, columns, minDistance int) error {
+	if err := ValidateConfig(rows, columns, minDistance); err != nil {
+		return err
+	}
 	if c.rows != rows || c.columns != columns {
-		seats := make([][]SeatStatus, rows)
-		for i := range seats {
-			seats[i] = make([]SeatStatus, columns)
-		}
+		c.seats = newSeatGrid(rows, columns)
+		c.categories = make(map[[2]int]SeatCategory)
+		c.unsellable = make(map[[2]int]bool)
+		c.holds = make(map[string]Hold)
 	}
 	c.rows = rows
 	c.columns = columns
 	c.minDistance = minDistance
+	c.configChanged()
+	return nil
+}
+
+// SetSeatCategory assigns the category to the given seats
+func (c *Cinema) SetSeatCategory(seatCoords [][]int, category SeatCategory) error {
+	if category < Standard || category >= SeatCategoryEnd {
+		return fmt.Errorf("unknown seat category %d", category)
+	}
+	if err := c.validate(seatCoords); err != nil {
+		return err
+	}
+	if c.categories == nil {
+		c.categories = make(map[[2]int]SeatCategory)
+	}
+	for _, seat := range seatCoords {
+		if category == Standard {
+			delete(c.categories, [2]int{seat[Row], seat[Col]})
+			continue
+		}
+		c.categories[[2]int{seat[Row], seat[Col]}] = category
+	}
+	c.configChanged()
+	return nil
+}
+
+// ResetSeatCategories makes every seat a standard one
+func (c *Cinema) ResetSeatCategories() {
+	c.categories = make(map[[2]int]SeatCategory)
+	c.configChanged()
+}
+
+// SeatCategoryOf returns the category of a seat
+func (c *Cinema) SeatCategoryOf(row, col int) SeatCategory {
+	return c.categories[[2]int{row, col}]
+}
+
+// SetUnsellable marks the seats as never reservable
+func (c *Cinema) SetUnsellable(seatCoords [][]int) error {
+	if err := c.validate(seatCoords); err != nil {
+		return err
+	}
+	if c.unsellable == nil {
+		c.unsellable = make(map[[2]int]bool)
+	}
+	for _, seat := range seatCoords {
+		if c.seats.at(seat[Row], seat[Col]).status == Reserved {
+			return fmt.Errorf("seat (%d, %d) is reserved", seat[Row], seat[Col])
+		}
+		c.unsellable[[2]int{seat[Row], seat[Col]}] = true
+	}
+	c.configChanged()
+	return nil
+}
+
+// ResetUnsellable makes every seat sellable
+func (c *Cinema) ResetUnsellable() {
+	c.unsellable = make(map[[2]int]bool)
+	c.configChanged()
+}
+
+// IsUnsellable reports whether the seat can never be reserved
+func (c *Cinema) IsUnsellable(row, col int) bool {
+	return c.unsellable[[2]int{row, col}]
+}
+
+// SetPrices replaces the price table, categories without a price cost as much as a standard seat
+func (c *Cinema) SetPrices(currency string, prices map[SeatCategory]int64) error {
+	table := make(map[SeatCategory]int64, len(prices))
+	for category, price := range prices {
+		if category < Standard || category >= SeatCategoryEnd {
+			return fmt.Errorf("unknown seat category %d", category)
+		}
+		if price < 0 {
+			return fmt.Errorf("price of category %d must not be negative", category)
+		}
+		table[category] = price
+	}
+	c.currency = currency
+	c.prices = table
+	c.configChanged()
+	return nil
+}
+
+func (c *Cinema) priceOf(category SeatCategory) int64 {
+	if price, ok := c.prices[category]; ok {
+		return price
+	}
+	return c.prices[Standard]
+}
+
+// PriceSeats computes the price breakdown of the given seats
+func (c *Cinema) PriceSeats(seatCoords [][]int) (*PriceBreakdown, error) {
+	if err := c.validate(seatCoords); err != nil {
+		return nil, err
+	}
+	result := &PriceBreakdown{
+		Currency: c.currency,
+		Items:    make([]SeatPrice, 0, len(seatCoords)),
+	}
+	for _, seat := range seatCoords {
+		category := c.SeatCategoryOf(seat[Row], seat[Col])
+		price := c.priceOf(category)
+		result.Items = append(result.Items, SeatPrice{
+			Row:      seat[Row],
+			Col:      seat[Col],
+			Label:    c.SeatLabel(seat[Row], seat[Col]),
+			Category: category,
+			Price:    price,
+		})
+		result.Total += price
+	}
+	return result, nil
 }
 
 // IsValidGroup checks if a group of seats can be reserved together
@@ -99,32 +299,33 @@ func (c *Cinema) IsValidGroup(seatCoords [][]int, groupName string) bool {
 		return false
 	}
 
-	// Check if the group contains any reserved seats
+	return len(c.conflicts(seatCoords, groupName, 1)) == 0
+}
+
+// ReserveSeats attempts to reserve seats if they are valid according to the distance rule
+// and the accessibility rules
+func (c *Cinema) ReserveSeats(seatCoords [][]int, groupName string, opts ReserveOptions) error {
+	if err := c.checkReservation(seatCoords, groupName, opts); err != nil {
+		return err
+	}
 	for _, seat := range seatCoords {
-		if c.seats[seat[Row]][seat[Col]].status == Reserved {
-			return false // Seat is already reserved
-		}
+		c.seats.reserve(seat[Row], seat[Col], groupName)
 	}
+	c.emit(SeatsReserved{GroupName: groupName, SeatCoords: seatCoords})
+	c.leaveWaitlistOnReservation(groupName)
+	return nil
+}
 
-	// Check if the group satisfies the minimum distance rule
-	for i := 0; i < c.rows; i++ {
-		for j := 0; j < c.columns; j++ {
-			if c.seats[i][j].status != Reserved || c.seats[i][j].groupName == groupName {
-				continue
-			}
-			// check minimum distance for different groups of seats
-			for _, seat := range seatCoords {
-				if helper.ManhattanDistance(i, j, seat[Row], seat[Col]) <= c.minDistance {
-					return false
-				}
-			}
-		}
+// checkReservation returns the reason why the seats cannot be reserved by the group, if any
+func (c *Cinema) checkReservation(seatCoords [][]int, groupName string, opts ReserveOptions) error {
+	if err := c.checkSeats(seatCoords, groupName, opts); err != nil {
+		return err
 	}
-	return true
+	return c.checkAdjacency(seatCoords, groupName, opts)
 }
 
-// ReserveSeats attempts to reserve seats if they are valid according to the distance rule
-func (c *Cinema) ReserveSeats(seatCoords [][]int, groupName string) error {
+// checkSeats checks the seats against the distance and the accessibility rules
+func (c *Cinema) checkSeats(seatCoords [][]int, groupName string, opts ReserveOptions) error {
 	if err := c.validate(seatCoords); err != nil {
 		return err
 	}
@@ -132,11 +333,7 @@ func (c *Cinema) ReserveSeats(seatCoords [][]int, groupName string) error {
 	if !c.IsValidGroup(seatCoords, groupName) {
 		return errors.New("seats are not available right now")
 	}
-	for _, seat := range seatCoords {
-		c.seats[seat[Row]][seat[Col]].status = Reserved
-		c.seats[seat[Row]][seat[Col]].groupName = groupName
-	}
-	return nil
+	return c.checkAccessibility(seatCoords, groupName, opts)
 }
 
 // CancelSeats cancels the reservation of specific seats
@@ -146,12 +343,13 @@ func (c *Cinema) CancelSeats(seatCoords [][]int) error {
 	}
 
 	for _, seat := range seatCoords {
-		if c.seats[seat[Row]][seat[Col]].status == Available {
+		if c.seats.at(seat[Row], seat[Col]).status == Available {
 			return fmt.Errorf("seat (%d, %d) is not reserved", seat[Row], seat[Col])
 		}
-		c.seats[seat[Row]][seat[Col]].status = Available
-		c.seats[seat[Row]][seat[Col]].groupName = ""
 	}
+	e := SeatsCancelled{SeatCoords: seatCoords}
+	_ = e.apply(c)
+	c.emit(e)
 	return nil
 }
 
@@ -160,7 +358,7 @@ func (c *Cinema) ListAvailableSeatsGrouped() [][]int {
 	availableGroups := make([][]int, 0)
 	for i := 0; i < c.rows; i++ {
 		for j := 0; j < c.columns; j++ {
-			if c.seats[i][j].status == Available {
+			if c.seats.at(i, j).status == Available && !c.IsUnsellable(i, j) {
 				availableGroups = append(availableGroups, []int{i, j})
 			}
 		}
@@ -169,32 +367,85 @@ func (c *Cinema) ListAvailableSeatsGrouped() [][]int {
 	return availableGroups
 }
 
+// ListAvailableSeats returns available seats as {row, column, category}, filtered by categories if any given
+func (c *Cinema) ListAvailableSeats(categories ...SeatCategory) [][]int {
+	wanted := make(map[SeatCategory]bool, len(categories))
+	for _, category := range categories {
+		wanted[category] = true
+	}
+	result := make([][]int, 0)
+	for i := 0; i < c.rows; i++ {
+		for j := 0; j < c.columns; j++ {
+			if c.seats.at(i, j).status != Available || c.IsUnsellable(i, j) {
+				continue
+			}
+			category := c.SeatCategoryOf(i, j)
+			if len(wanted) > 0 && !wanted[category] {
+				continue
+			}
+			result = append(result, []int{i, j, int(category)})
+		}
+	}
+	return result
+}
+
 func (c *Cinema) ToPbSeats(seats [][]int) ([]*cinema.Seat, error) {
 	result := make([]*cinema.Seat, 0)
 	for _, seat := range seats {
 		if len(seat) < 2 {
 			return nil, errors.New("malformed seat data")
 		}
-		result = append(result, &cinema.Seat{
+		pb := &cinema.Seat{
 			Row:    int32(seat[Row]),
 			Column: int32(seat[Col]),
-		})
+			Label:  c.SeatLabel(seat[Row], seat[Col]),
+		}
+		if len(seat) > Cat {
+			pb.Category = cinema.SeatCategory(seat[Cat])
+		}
+		result = append(result, pb)
 	}
 	return result, nil
 }
 
-// PrintLayout prints the current layout of the cinema (for testing purposes)
+func (p *PriceBreakdown) ToPb() *cinema.ReserveSeatsResponse {
+	items := make([]*cinema.SeatPrice, 0, len(p.Items))
+	for _, item := range p.Items {
+		items = append(items, &cinema.SeatPrice{
+			Seat: &cinema.Seat{
+				Row:      int32(item.Row),
+				Column:   int32(item.Col),
+				Category: cinema.SeatCategory(item.Category),
+				Label:    item.Label,
+			},
+			Price: item.Price,
+		})
+	}
+	return &cinema.ReserveSeatsResponse{
+		Success:  true,
+		Items:    items,
+		Total:    p.Total,
+		Currency: p.Currency,
+	}
+}
+
+// PrintLayout prints the current layout of the cinema (for testing purposes),
+// every seat is its status followed by its category code, e.g. "0S 1V", unsellable seats are "X"
 func (c *Cinema) String() string {
 	var sb strings.Builder
 
-	for i, row := range c.seats {
-		for j, val := range row {
-			sb.WriteString(fmt.Sprintf("%d", val.status))
-			if j < len(row)-1 {
+	for i := 0; i < c.seats.rows; i++ {
+		for j := 0; j < c.seats.columns; j++ {
+			if c.IsUnsellable(i, j) {
+				sb.WriteString(fmt.Sprintf("X%c", categoryCodes[c.SeatCategoryOf(i, j)]))
+			} else {
+				sb.WriteString(fmt.Sprintf("%d%c", c.seats.at(i, j).status, categoryCodes[c.SeatCategoryOf(i, j)]))
+			}
+			if j < c.seats.columns-1 {
 				sb.WriteString(" ") // Add a space between numbers in the same row
 			}
 		}
-		if i < len(c.seats)-1 {
+		if i < c.seats.rows-1 {
 			sb.WriteString("\n") // Add a newline between rows
 		}
 	}
@@ -202,20 +453,47 @@ func (c *Cinema) String() string {
 	return sb.String()
 }
 
+// Clone returns a copy of the cinema along with the events not taken yet, a pending
+// ConfigUpdated included, the seats are only copied once one of the two changes them
 func (c *Cinema) Clone() *Cinema {
 	// Create a new Cinema struct
 	newCinema := &Cinema{
 		logger:      c.logger,
+		name:        c.name,
+		description: c.description,
 		rows:        c.rows,
 		columns:     c.columns,
 		minDistance: c.minDistance,
-		seats:       make([][]Seat, len(c.seats)),
-	}
+		separation:  c.separation,
+		seats:       c.seats.clone(),
+		categories:  make(map[[2]int]SeatCategory, len(c.categories)),
+		unsellable:  make(map[[2]int]bool, len(c.unsellable)),
+		labels:      c.labels,
+		adjacency:   c.adjacency,
+		currency:    c.currency,
+		prices:      make(map[SeatCategory]int64, len(c.prices)),
+
+		showTime:          c.showTime,
+		accessibleRelease: c.accessibleRelease,
+
+		policies:      slices.Clone(c.policies),
+		policyApplied: c.policyApplied,
+
+		waitlist: slices.Clone(c.waitlist),
+		holds:    maps.Clone(c.holds),
 
-	// Deep copy of the seats slice
-	for i := range c.seats {
-		newCinema.seats[i] = make([]Seat, len(c.seats[i]))
-		copy(newCinema.seats[i], c.seats[i]) // Copy the seat statuses row by row
+		events:      slices.Clone(c.events),
+		configured:  c.configured,
+		configDirty: c.configDirty,
+	}
+	for coord, category := range c.categories {
+		newCinema.categories[coord] = category
+	}
+	for coord := range c.unsellable {
+		newCinema.unsellable[coord] = true
+	}
+	for category, price := range c.prices {
+		newCinema.prices[category] = price
 	}
 
 	return newCinema
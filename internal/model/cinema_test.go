@@ -1,7 +1,12 @@
 package model
 
 import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -92,7 +97,7 @@ func TestCinema_IsValidGroup(t *testing.T) {
 				rows:        tt.fields.rows,
 				columns:     tt.fields.columns,
 				minDistance: tt.fields.minDistance,
-				seats:       tt.fields.seats,
+				seats:       gridOf(tt.fields.seats),
 			}
 			if got := c.IsValidGroup(tt.args.seatCoords, tt.args.groupName); got != tt.want {
 				t.Errorf("IsValidGroup() = %v, want %v", got, tt.want)
@@ -100,3 +105,964 @@ func TestCinema_IsValidGroup(t *testing.T) {
 		})
 	}
 }
+
+// gridOf stores the seats of a table in a grid
+func gridOf(seats [][]Seat) seatGrid {
+	g := newSeatGrid(len(seats), len(seats[0]))
+	for i, row := range seats {
+		for j, seat := range row {
+			if seat.status == Reserved {
+				g.reserve(i, j, seat.groupName)
+			}
+		}
+	}
+	return g
+}
+
+func TestCinema_PriceSeats(t *testing.T) {
+	c := NewCinema(log.StandardLogger(), 3, 4, 1)
+	if err := c.SetSeatCategory([][]int{{0, 0}, {0, 1}}, VIP); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetSeatCategory([][]int{{2, 3}}, Wheelchair); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetPrices("USD", map[SeatCategory]int64{Standard: 1000, VIP: 2500}); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name       string
+		seatCoords [][]int
+		wantTotal  int64
+		wantErr    bool
+	}{
+		{
+			name:       "vip seats",
+			seatCoords: [][]int{{0, 0}, {0, 1}},
+			wantTotal:  5000,
+		},
+		{
+			name:       "category without price costs as standard",
+			seatCoords: [][]int{{2, 3}, {1, 1}},
+			wantTotal:  2000,
+		},
+		{
+			name:       "fail bc out of range",
+			seatCoords: [][]int{{3, 0}},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.PriceSeats(tt.seatCoords)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PriceSeats() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.Total != tt.wantTotal {
+				t.Errorf("PriceSeats() total = %v, want %v", got.Total, tt.wantTotal)
+			}
+		})
+	}
+}
+
+func TestCinema_ReserveSeats_Accessibility(t *testing.T) {
+	showTime := time.Date(2024, 10, 1, 20, 0, 0, 0, time.UTC)
+	newCinema := func() *Cinema {
+		c := NewCinema(log.StandardLogger(), 3, 5, 1)
+		if err := c.SetSeatCategory([][]int{{2, 0}}, Wheelchair); err != nil {
+			t.Fatal(err)
+		}
+		if err := c.SetSeatCategory([][]int{{2, 1}}, Companion); err != nil {
+			t.Fatal(err)
+		}
+		if err := c.SetShowTime(showTime, time.Hour); err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	tests := []struct {
+		name       string
+		seatCoords [][]int
+		opts       ReserveOptions
+		wantErr    bool
+	}{
+		{
+			name:       "pass /w wheelchair and companion",
+			seatCoords: [][]int{{2, 0}, {2, 1}},
+			opts:       ReserveOptions{Accessible: true, At: showTime.Add(-2 * time.Hour)},
+		},
+		{
+			name:       "fail bc wheelchair space without need",
+			seatCoords: [][]int{{2, 0}},
+			opts:       ReserveOptions{At: showTime.Add(-2 * time.Hour)},
+			wantErr:    true,
+		},
+		{
+			name:       "fail bc companion without wheelchair space",
+			seatCoords: [][]int{{2, 1}, {2, 2}},
+			opts:       ReserveOptions{Accessible: true, At: showTime.Add(-2 * time.Hour)},
+			wantErr:    true,
+		},
+		{
+			name:       "pass bc released to general sale",
+			seatCoords: [][]int{{2, 0}, {2, 1}},
+			opts:       ReserveOptions{At: showTime.Add(-30 * time.Minute)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := newCinema().ReserveSeats(tt.seatCoords, "a", tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ReserveSeats() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCinema_SuggestSeats(t *testing.T) {
+	c := NewCinema(log.StandardLogger(), 3, 5, 1)
+	if err := c.SetSeatCategory([][]int{{2, 3}}, Wheelchair); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetSeatCategory([][]int{{2, 4}}, Companion); err != nil {
+		t.Fatal(err)
+	}
+	got := c.SuggestSeats(2, "a", true, time.Now())
+	if len(got) != 2 || c.SeatCategoryOf(got[0][Row], got[0][Col]) != Wheelchair {
+		t.Errorf("SuggestSeats() accessible = %v, want the wheelchair space first", got)
+	}
+	for _, seat := range c.SuggestSeats(2, "b", false, time.Now()) {
+		if isAccessible(c.SeatCategoryOf(seat[Row], seat[Col])) {
+			t.Errorf("SuggestSeats() = %v, want no accessible seat", seat)
+		}
+	}
+}
+
+func TestCinema_BatchReserve(t *testing.T) {
+	tests := []struct {
+		name       string
+		groups     []GroupReservation
+		wantFailed []bool
+	}{
+		{
+			name: "pass /w distant groups",
+			groups: []GroupReservation{
+				{GroupName: "a", SeatCoords: [][]int{{0, 0}, {0, 1}}},
+				{GroupName: "b", SeatCoords: [][]int{{3, 3}, {3, 4}}},
+			},
+			wantFailed: []bool{false, false},
+		},
+		{
+			name: "fail bc groups of the batch too close",
+			groups: []GroupReservation{
+				{GroupName: "a", SeatCoords: [][]int{{0, 0}}},
+				{GroupName: "b", SeatCoords: [][]int{{0, 2}}},
+				{GroupName: "c", SeatCoords: [][]int{{3, 4}}},
+			},
+			wantFailed: []bool{true, true, false},
+		},
+		{
+			name: "fail bc too close to existing reservation",
+			groups: []GroupReservation{
+				{GroupName: "a", SeatCoords: [][]int{{1, 4}}},
+			},
+			wantFailed: []bool{true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCinema(log.StandardLogger(), 4, 5, 2)
+			if err := c.ReserveSeats([][]int{{0, 4}}, "x", ReserveOptions{}); err != nil {
+				t.Fatal(err)
+			}
+			before := c.String()
+			errs, err := c.BatchReserve(tt.groups)
+			for i, wantFailed := range tt.wantFailed {
+				if (errs[i] != nil) != wantFailed {
+					t.Errorf("BatchReserve() group %d error = %v, wantFailed %v", i, errs[i], wantFailed)
+				}
+			}
+			if err != nil && c.String() != before {
+				t.Errorf("BatchReserve() changed seats of a rejected batch")
+			}
+		})
+	}
+}
+
+func TestCinema_PlanSeating(t *testing.T) {
+	parties := []Party{
+		{GroupName: "a", Size: 3},
+		{GroupName: "b", Size: 2},
+		{GroupName: "c", Size: 2},
+		{GroupName: "d", Size: 5},
+	}
+	tests := []struct {
+		name       string
+		opts       PlanOptions
+		wantPeople int
+	}{
+		{
+			name:       "greedy",
+			opts:       PlanOptions{Objective: MaximizePeople},
+			wantPeople: 7,
+		},
+		{
+			name:       "exact",
+			opts:       PlanOptions{Objective: MaximizePeople, Exact: true, Timeout: time.Second},
+			wantPeople: 7,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCinema(log.StandardLogger(), 3, 4, 1)
+			before := c.String()
+			got, err := c.PlanSeating(parties, tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.SeatedPeople != tt.wantPeople {
+				t.Errorf("PlanSeating() seated = %v, want %v", got.SeatedPeople, tt.wantPeople)
+			}
+			if c.String() != before {
+				t.Errorf("PlanSeating() changed the cinema")
+			}
+			reservations := make([]GroupReservation, 0)
+			for _, a := range got.Assignments {
+				reservations = append(reservations, GroupReservation{GroupName: a.GroupName, SeatCoords: a.SeatCoords})
+			}
+			if _, err := c.BatchReserve(reservations); err != nil {
+				t.Errorf("PlanSeating() returned a plan that cannot be reserved: %v", err)
+			}
+		})
+	}
+}
+
+func TestCinema_PlanSeatingDeadline(t *testing.T) {
+	c := NewCinema(log.StandardLogger(), 100, 100, 1)
+	parties := make([]Party, 0, 300)
+	for i := range 300 {
+		parties = append(parties, Party{GroupName: fmt.Sprintf("p%d", i), Size: 3})
+	}
+	start := time.Now()
+	got, err := c.PlanSeating(parties, PlanOptions{Exact: true, Timeout: 50 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("PlanSeating() took %v with a 50ms timeout", elapsed)
+	}
+	if got.Optimal || len(got.Unseated) == 0 {
+		t.Errorf("PlanSeating() seated %d parties and is optimal %v, want it cut short", got.SeatedParties, got.Optimal)
+	}
+}
+
+func TestCinema_AnalyzeCapacity(t *testing.T) {
+	c := NewCinema(log.StandardLogger(), 4, 4, 1)
+	if err := c.ReserveSeats([][]int{{0, 0}}, "a", ReserveOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := c.AnalyzeCapacity([]int{0, 1}, []int{2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []CapacityScenario{
+		{MinDistance: 0, MaxSeats: 16, RemainingSeats: 15, DeadSeats: 0, Groups: []GroupCapacity{{2, 8, 7}}},
+		{MinDistance: 1, MaxSeats: 8, RemainingSeats: 7, DeadSeats: 2, Groups: []GroupCapacity{{2, 4, 3}}},
+	}
+	if got.ReservedSeats != 1 || len(got.Scenarios) != len(want) {
+		t.Fatalf("AnalyzeCapacity() = %+v", got)
+	}
+	for i := range want {
+		if fmt.Sprint(got.Scenarios[i]) != fmt.Sprint(want[i]) {
+			t.Errorf("AnalyzeCapacity() scenario = %+v, want %+v", got.Scenarios[i], want[i])
+		}
+	}
+}
+
+func TestCinema_SeatMap(t *testing.T) {
+	c := NewCinema(log.StandardLogger(), 2, 4, 1)
+	if err := c.ReserveSeats([][]int{{0, 0}}, "a", ReserveOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetUnsellable([][]int{{1, 3}}); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name      string
+		groupName string
+		want      [][]SeatState
+	}{
+		{
+			name:      "other group",
+			groupName: "b",
+			want: [][]SeatState{
+				{StateReserved, StateBlocked, StateAvailable, StateAvailable},
+				{StateBlocked, StateAvailable, StateAvailable, StateUnsellable},
+			},
+		},
+		{
+			name:      "same group",
+			groupName: "a",
+			want: [][]SeatState{
+				{StateReserved, StateAvailable, StateAvailable, StateAvailable},
+				{StateAvailable, StateAvailable, StateAvailable, StateUnsellable},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := c.SeatMap(tt.groupName)
+			for i := range tt.want {
+				for j := range tt.want[i] {
+					if got.Seats[i][j].State != tt.want[i][j] {
+						t.Errorf("SeatMap() seat (%d, %d) = %v, want %v", i, j, got.Seats[i][j].State, tt.want[i][j])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestSeatMap_ToPb(t *testing.T) {
+	m := &SeatMap{
+		Rows:         1,
+		Columns:      4,
+		RowLabels:    []string{"A"},
+		ColumnLabels: []string{"1", "2", "3", "4"},
+		Seats: [][]SeatView{{
+			{Col: 0, State: StateReserved, GroupName: "a"},
+			{Col: 1, State: StateHeld, GroupName: "a"},
+			{Col: 2, State: StateReserved, GroupName: "b"},
+			{Col: 3, State: StateAvailable},
+		}},
+	}
+	for _, tt := range []struct {
+		groupName string
+		want      string
+	}{
+		{"a", "[true true false false]"},
+		{"", "[false false false false]"},
+	} {
+		owned := make([]bool, 0, 4)
+		for _, seat := range m.ToPb(tt.groupName, false).SeatRows[0].Seats {
+			owned = append(owned, seat.Owned)
+			if !seat.Owned && seat.GroupName != "" {
+				t.Errorf("ToPb(%q) reveals group %s of seat %d", tt.groupName, seat.GroupName, seat.Seat.Column)
+			}
+		}
+		if got := fmt.Sprint(owned); got != tt.want {
+			t.Errorf("ToPb(%q) owned = %s, want %s", tt.groupName, got, tt.want)
+		}
+	}
+}
+
+func TestCinema_SeatLabel(t *testing.T) {
+	tests := []struct {
+		name   string
+		scheme LabelScheme
+		row    int
+		col    int
+		want   string
+	}{
+		{name: "default", row: 5, col: 11, want: "F12"},
+		{name: "skip I", scheme: LabelScheme{SkipLetters: "I"}, row: 8, col: 0, want: "J1"},
+		{name: "after Z", row: 26, col: 0, want: "AA1"},
+		{name: "numbered rows", scheme: LabelScheme{RowStyle: RowNumbers}, row: 5, col: 11, want: "6-12"},
+		{name: "right to left", scheme: LabelScheme{Numbering: RightToLeft}, row: 0, col: 0, want: "A12"},
+		{name: "from center left", scheme: LabelScheme{Numbering: FromCenter}, row: 0, col: 5, want: "AL1"},
+		{name: "from center right", scheme: LabelScheme{Numbering: FromCenter}, row: 0, col: 6, want: "AR1"},
+		{name: "odd left", scheme: LabelScheme{Numbering: OddEven}, row: 0, col: 4, want: "A3"},
+		{name: "even right", scheme: LabelScheme{Numbering: OddEven}, row: 0, col: 7, want: "A4"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCinema(log.StandardLogger(), 30, 12, 1)
+			if err := c.SetLabelScheme(tt.scheme); err != nil {
+				t.Fatal(err)
+			}
+			if got := c.SeatLabel(tt.row, tt.col); got != tt.want {
+				t.Errorf("SeatLabel() = %v, want %v", got, tt.want)
+			}
+			row, col, err := c.ParseSeatLabel(strings.ToLower(tt.want))
+			if err != nil || row != tt.row || col != tt.col {
+				t.Errorf("ParseSeatLabel() = (%v, %v, %v), want (%v, %v)", row, col, err, tt.row, tt.col)
+			}
+		})
+	}
+}
+
+func TestCinema_ExportImport(t *testing.T) {
+	c := NewCinema(log.StandardLogger(), 3, 5, 1)
+	_ = c.SetSeatCategory([][]int{{0, 1}, {0, 2}, {2, 3}}, VIP)
+	_ = c.SetSeatCategory([][]int{{2, 0}}, Wheelchair)
+	_ = c.SetUnsellable([][]int{{2, 4}, {2, 3}})
+	_ = c.SetPrices("USD", map[SeatCategory]int64{Standard: 800, VIP: 1500})
+	_ = c.SetLabelScheme(LabelScheme{Numbering: RightToLeft})
+	if err := c.ReserveSeats([][]int{{0, 1}, {0, 2}}, "smith", ReserveOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.ReserveSeats([][]int{{2, 0}}, "lee", ReserveOptions{Accessible: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, format := range []LayoutFormat{FormatJSON, FormatYAML, FormatCSV} {
+		t.Run(fmt.Sprint(format), func(t *testing.T) {
+			data, err := EncodeLayout(c.Export(true), format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			layout, err := DecodeLayout(data, format, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			imported, err := Import(log.StandardLogger(), layout)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if imported.String() != c.String() {
+				t.Errorf("Import() = \n%v, want \n%v", imported, c)
+			}
+			if format != FormatCSV && imported.SeatLabel(0, 0) != "A5" {
+				t.Errorf("labels were not imported, got %v", imported.SeatLabel(0, 0))
+			}
+		})
+	}
+
+	// reservations follow the rules of ReserveSeats
+	tests := []struct {
+		name   string
+		change func(layout *Layout)
+	}{
+		{"wheelchair space", func(layout *Layout) { layout.Reservations[1].Accessible = false }},
+		{"adjacency", func(layout *Layout) {
+			layout.Adjacency = AdjacencySameRow.String()
+			layout.Reservations[0].Seats = [][2]int{{0, 1}, {1, 4}}
+		}},
+		{"too close", func(layout *Layout) { layout.Reservations[1].Seats = [][2]int{{1, 1}} }},
+	}
+	for _, tt := range tests {
+		layout := c.Export(true)
+		tt.change(layout)
+		if _, err := Import(log.StandardLogger(), layout); err == nil {
+			t.Errorf("Import() with a reservation breaking the %s rule succeeded", tt.name)
+		}
+	}
+	layout := c.Export(true)
+	layout.Rows = 100000
+	if _, err := Import(log.StandardLogger(), layout); err == nil {
+		t.Errorf("Import() of 100000 rows succeeded")
+	}
+}
+
+func TestCinema_RevertTo(t *testing.T) {
+	c := NewCinema(log.StandardLogger(), 3, 6, 1)
+	_ = c.ReserveSeats([][]int{{0, 0}, {0, 1}}, "a", ReserveOptions{})
+	old := c.Clone()
+	_ = c.CancelSeats([][]int{{0, 0}, {0, 1}})
+	_ = c.ReserveSeats([][]int{{2, 5}}, "b", ReserveOptions{})
+
+	changes, err := old.Diff(c)
+	if err != nil || len(changes) != 3 {
+		t.Fatalf("Diff() = %v, %v, want 3 changes", changes, err)
+	}
+
+	reverted := c.Clone()
+	if _, err := reverted.RevertTo(old, false); err != nil {
+		t.Fatal(err)
+	}
+	if reverted.seats.at(0, 0).groupName != "a" || reverted.seats.at(2, 5).groupName != "b" {
+		t.Errorf("RevertTo() = \n%v, want the seats of a restored and b kept", reverted)
+	}
+
+	discarded := c.Clone()
+	if _, err := discarded.RevertTo(old, true); err != nil {
+		t.Fatal(err)
+	}
+	if discarded.seats.at(2, 5).status != Available {
+		t.Errorf("RevertTo() kept the newer seat of b")
+	}
+
+	// a new group now sits next to the cancelled seats
+	_ = c.ReserveSeats([][]int{{1, 1}}, "c", ReserveOptions{})
+	if _, err := c.RevertTo(old, false); err == nil {
+		t.Errorf("RevertTo() restored seats too close to another group")
+	}
+}
+
+func TestCinema_Replay(t *testing.T) {
+	c := NewCinema(log.StandardLogger(), 4, 6, 1)
+	_ = c.SetSeatCategory([][]int{{0, 0}, {0, 1}}, VIP)
+	_ = c.SetUnsellable([][]int{{3, 5}})
+	_ = c.ReserveSeats([][]int{{0, 0}, {0, 1}}, "a", ReserveOptions{})
+	_, _ = c.BatchReserve([]GroupReservation{
+		{GroupName: "b", SeatCoords: [][]int{{2, 0}}},
+		{GroupName: "c", SeatCoords: [][]int{{2, 4}}},
+	})
+	_ = c.CancelSeats([][]int{{0, 1}})
+	_ = c.SetLabelScheme(LabelScheme{RowStyle: RowNumbers})
+	_ = c.ReserveSeats([][]int{{0, 4}}, "d", ReserveOptions{})
+
+	events := c.TakeEvents()
+	if _, ok := events[0].(CinemaConfigured); !ok {
+		t.Fatalf("first event = %T, want CinemaConfigured", events[0])
+	}
+	if len(c.TakeEvents()) != 0 {
+		t.Errorf("TakeEvents() returned the events twice")
+	}
+	replayed, err := Replay(log.StandardLogger(), events)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayed.String() != c.String() || replayed.SeatLabel(0, 0) != c.SeatLabel(0, 0) {
+		t.Errorf("Replay() = \n%v, want \n%v", replayed, c)
+	}
+
+	// an update of the setup before a reservation is replayed before it
+	if err := c.UpdateConfig(5, 6, 0); err != nil {
+		t.Fatal(err)
+	}
+	_ = c.ReserveSeats([][]int{{4, 0}}, "e", ReserveOptions{})
+	events = append(events, c.TakeEvents()...)
+	replayed, err = Replay(log.StandardLogger(), events)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayed.String() != c.String() {
+		t.Errorf("Replay() after resize = \n%v, want \n%v", replayed, c)
+	}
+}
+
+func TestCinema_Waitlist(t *testing.T) {
+	now := time.Now()
+	c := NewCinema(log.StandardLogger(), 1, 5, 1)
+	_ = c.ReserveSeats([][]int{{0, 0}, {0, 1}}, "a", ReserveOptions{})
+	_ = c.ReserveSeats([][]int{{0, 3}, {0, 4}}, "b", ReserveOptions{})
+	if _, err := c.JoinWaitlist(WaitlistEntry{GroupName: "a", Size: 1}); err == nil {
+		t.Errorf("JoinWaitlist() of a group with seats succeeded")
+	}
+	_, _ = c.JoinWaitlist(WaitlistEntry{GroupName: "c", Size: 2, AutoHold: true, HoldFor: time.Minute})
+	if position, _ := c.JoinWaitlist(WaitlistEntry{GroupName: "d", Size: 1}); position != 2 {
+		t.Errorf("position of d = %d, want 2", position)
+	}
+	if offers := c.ServeWaitlist(now); len(offers) != 0 {
+		t.Errorf("ServeWaitlist() of a full cinema = %v", offers)
+	}
+
+	// c gets the seats of b held, d is notified of the seats left
+	_ = c.CancelSeats([][]int{{0, 3}, {0, 4}})
+	offers := c.ServeWaitlist(now)
+	if len(offers) != 1 || !offers[0].Held || offers[0].GroupName != "c" {
+		t.Fatalf("ServeWaitlist() = %+v, want the seats held for c", offers)
+	}
+	if position, _ := c.WaitlistPosition("d"); position != 1 {
+		t.Errorf("position of d = %d, want 1", position)
+	}
+	if view := c.SeatMap("").Seats[0][4]; view.State != StateHeld || view.GroupName != "c" {
+		t.Errorf("seat (0, 4) = %+v, want held by c", view)
+	}
+	_ = c.CancelSeats([][]int{{0, 0}, {0, 1}})
+	offers = c.ServeWaitlist(now)
+	if len(offers) != 1 || offers[0].Held || offers[0].GroupName != "d" {
+		t.Errorf("ServeWaitlist() = %+v, want d notified", offers)
+	}
+
+	// expired holds are released
+	if err := c.ConfirmHold("c", now.Add(time.Hour)); err == nil {
+		t.Errorf("ConfirmHold() of an expired hold succeeded")
+	}
+	c.ReleaseExpiredHolds(now.Add(time.Hour))
+	if _, ok := c.HoldOf("c"); ok || c.seats.at(0, 4).status != Available {
+		t.Errorf("hold of c not released")
+	}
+
+	replayed, err := Replay(log.StandardLogger(), c.TakeEvents())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if position, _ := replayed.WaitlistPosition("d"); position != 1 || replayed.String() != c.String() {
+		t.Errorf("Replay() = \n%v, d at %d, want \n%v, d at 1", replayed, position, c)
+	}
+}
+
+func TestCinema_CancelHeldSeats(t *testing.T) {
+	now := time.Now()
+	c := NewCinema(log.StandardLogger(), 1, 5, 1)
+	_, _ = c.JoinWaitlist(WaitlistEntry{GroupName: "c", Size: 2, AutoHold: true, HoldFor: time.Minute})
+	if offers := c.ServeWaitlist(now); len(offers) != 1 || !offers[0].Held {
+		t.Fatalf("ServeWaitlist() = %+v, want seats held for c", offers)
+	}
+	held, _ := c.HoldOf("c")
+
+	// the held seats are cancelled then reserved again by the same group
+	if err := c.CancelSeats(held.SeatCoords[:1]); err != nil {
+		t.Fatal(err)
+	}
+	if hold, ok := c.HoldOf("c"); !ok || len(hold.SeatCoords) != 1 {
+		t.Errorf("HoldOf(c) = %+v, %v, want the seat left held", hold, ok)
+	}
+	if err := c.CancelSeats(held.SeatCoords[1:]); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := c.HoldOf("c"); ok {
+		t.Errorf("hold of c kept after every seat was cancelled")
+	}
+	if err := c.ReserveSeats(held.SeatCoords, "c", ReserveOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	c.ReleaseExpiredHolds(now.Add(time.Hour))
+	for _, seat := range held.SeatCoords {
+		if s := c.seats.at(seat[Row], seat[Col]); s.status != Reserved || s.groupName != "c" {
+			t.Errorf("seat %v released with the old hold, want it reserved by c", seat)
+		}
+	}
+
+	replayed, err := Replay(log.StandardLogger(), c.TakeEvents())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := replayed.HoldOf("c"); ok || replayed.String() != c.String() {
+		t.Errorf("Replay() = \n%v, want \n%v without holds", replayed, c)
+	}
+}
+
+func TestCinema_AdjacencyPolicy(t *testing.T) {
+	c := NewCinema(log.StandardLogger(), 3, 4, 0)
+	sameRow := &AdjacencyPolicy{Rule: AdjacencySameRow}
+	tests := []struct {
+		name       string
+		seatCoords [][]int
+		policy     *AdjacencyPolicy
+		wantSplit  bool
+	}{
+		{name: "same row", seatCoords: [][]int{{1, 2}, {1, 1}}, policy: sameRow},
+		{name: "gap in the row", seatCoords: [][]int{{1, 0}, {1, 2}}, policy: sameRow, wantSplit: true},
+		{name: "apart", seatCoords: [][]int{{0, 0}, {2, 3}}, policy: sameRow, wantSplit: true},
+		{name: "rows not connected", seatCoords: [][]int{{0, 0}, {1, 0}}, policy: sameRow, wantSplit: true},
+		{name: "connected", seatCoords: [][]int{{0, 0}, {1, 0}, {1, 1}}, policy: &AdjacencyPolicy{Rule: AdjacencyConnected}},
+		{name: "any", seatCoords: [][]int{{0, 0}, {2, 3}}},
+		{name: "split while a block is free", seatCoords: [][]int{{0, 0}, {2, 3}}, policy: &AdjacencyPolicy{Rule: AdjacencySameRow, AllowSplit: true}, wantSplit: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := c.checkReservation(tt.seatCoords, "a", ReserveOptions{Adjacency: tt.policy})
+			if got := errors.Is(err, ErrGroupSplit); got != tt.wantSplit {
+				t.Errorf("checkReservation() = %v, want split %v", err, tt.wantSplit)
+			}
+		})
+	}
+
+	// a split is allowed once no row has the seats free together
+	_ = c.ReserveSeats([][]int{{0, 1}}, "b", ReserveOptions{})
+	_ = c.ReserveSeats([][]int{{1, 2}}, "c", ReserveOptions{})
+	_ = c.ReserveSeats([][]int{{2, 1}}, "d", ReserveOptions{})
+	split := &AdjacencyPolicy{Rule: AdjacencySameRow, AllowSplit: true}
+	if err := c.ReserveSeats([][]int{{0, 2}, {0, 3}, {1, 0}}, "e", ReserveOptions{Adjacency: split}); err != nil {
+		t.Errorf("ReserveSeats() of a group that cannot sit together = %v", err)
+	}
+
+	// reservations may not loosen the policy of the cinema
+	strict := NewCinema(log.StandardLogger(), 3, 4, 0)
+	_ = strict.SetAdjacencyPolicy(*sameRow)
+	for _, policy := range []AdjacencyPolicy{{}, {Rule: AdjacencyConnected}, *split} {
+		err := strict.ReserveSeats([][]int{{0, 0}, {2, 3}}, "a", ReserveOptions{Adjacency: &policy})
+		if err == nil || errors.Is(err, ErrGroupSplit) {
+			t.Errorf("ReserveSeats() with the looser policy %s = %v", policy, err)
+		}
+	}
+
+	// nor split a group by reserving in several calls
+	if err := strict.ReserveSeats([][]int{{0, 0}}, "a", ReserveOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := strict.ReserveSeats([][]int{{2, 3}}, "a", ReserveOptions{}); !errors.Is(err, ErrGroupSplit) {
+		t.Errorf("ReserveSeats() of a seat apart from the group = %v, want a split", err)
+	}
+	if err := strict.ReserveSeats([][]int{{0, 1}}, "a", ReserveOptions{Adjacency: sameRow}); err != nil {
+		t.Errorf("ReserveSeats() of the seat next to the group = %v", err)
+	}
+}
+
+func TestCinema_SeparationProfile(t *testing.T) {
+	c := NewCinema(log.StandardLogger(), 3, 7, 5)
+	if err := c.SetSeparation(SeparationProfile{Mask: []string{"X.", "O."}}); err == nil {
+		t.Errorf("SetSeparation() with an even mask succeeded")
+	}
+	_ = c.SetSeparation(SeparationProfile{Horizontal: 2, Vertical: 1})
+	_ = c.ReserveSeats([][]int{{1, 2}}, "a", ReserveOptions{})
+	tests := []struct {
+		seat []int
+		want bool
+	}{
+		{seat: []int{1, 4}, want: false}, // 2 seats away in the row
+		{seat: []int{1, 5}, want: true},
+		{seat: []int{0, 2}, want: false}, // row in front
+		{seat: []int{0, 3}, want: true},  // no diagonal minimum
+	}
+	for _, tt := range tests {
+		if got := c.IsValidGroup([][]int{tt.seat}, "b"); got != tt.want {
+			t.Errorf("IsValidGroup(%v) = %v, want %v", tt.seat, got, tt.want)
+		}
+	}
+	if zone := strings.Join(c.ExclusionZone(), "\n"); zone != "..X..\nXXOXX\n..X.." {
+		t.Errorf("ExclusionZone() = \n%s", zone)
+	}
+
+	// a mask is applied both ways
+	_ = c.SetSeparation(SeparationProfile{Mask: []string{"X..", ".O.", "..."}})
+	if c.IsValidGroup([][]int{{2, 3}}, "b") || c.IsValidGroup([][]int{{0, 1}}, "b") || !c.IsValidGroup([][]int{{0, 3}}, "b") {
+		t.Errorf("IsValidGroup() does not follow the mask")
+	}
+}
+
+func TestCinema_DistancingPolicies(t *testing.T) {
+	c := NewCinema(log.StandardLogger(), 3, 7, 2)
+	now := time.Now()
+	if err := c.SetDistancingPolicies([]DistancingPolicy{{EffectiveFrom: now}, {EffectiveFrom: now}}, now); err == nil {
+		t.Errorf("SetDistancingPolicies() with two policies at the same time succeeded")
+	}
+	_ = c.SetDistancingPolicies([]DistancingPolicy{
+		{EffectiveFrom: now.Add(24 * time.Hour), MinDistance: 3},
+		{EffectiveFrom: now.Add(-time.Hour), MinDistance: 0},
+	}, now.Add(-2*time.Hour))
+	if c.ApplyDistancingPolicy(now.Add(-2 * time.Hour)) {
+		t.Errorf("ApplyDistancingPolicy() applied a policy before its effective time")
+	}
+	if !c.ApplyDistancingPolicy(now) || c.ApplyDistancingPolicy(now) {
+		t.Errorf("ApplyDistancingPolicy() must apply the current policy once")
+	}
+	_ = c.ReserveSeats([][]int{{1, 2}}, "a", ReserveOptions{})
+	_ = c.ReserveSeats([][]int{{1, 3}}, "b", ReserveOptions{})
+	_ = c.ReserveSeats([][]int{{1, 6}}, "c", ReserveOptions{})
+	if len(c.ListAvailableSeats()) != 18 {
+		t.Errorf("reservations next to each other are rejected without distancing")
+	}
+
+	next, ok := c.NextPolicy(now)
+	if !ok || next.MinDistance != 3 {
+		t.Fatalf("NextPolicy() = %v, %v", next, ok)
+	}
+	violations := c.PolicyViolations(next)
+	want := []PolicyViolation{
+		{GroupName: "a", SeatCoords: [][]int{{1, 2}}, TooCloseTo: []string{"b"}},
+		{GroupName: "b", SeatCoords: [][]int{{1, 3}}, TooCloseTo: []string{"a", "c"}},
+		{GroupName: "c", SeatCoords: [][]int{{1, 6}}, TooCloseTo: []string{"b"}},
+	}
+	for i := range violations {
+		violations[i].Seats = nil
+	}
+	if !reflect.DeepEqual(violations, want) {
+		t.Errorf("PolicyViolations() = %v, want %v", violations, want)
+	}
+
+	// the schedule survives a replay
+	replayed, err := Replay(log.StandardLogger(), c.TakeEvents())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayed.PolicyDue(now) || len(replayed.DistancingPolicies()) != 2 {
+		t.Errorf("Replay() lost the distancing policies")
+	}
+}
+
+func TestCinema_ReplaceDistancingPolicies(t *testing.T) {
+	c := NewCinema(log.StandardLogger(), 3, 7, 2)
+	now := time.Now()
+	if err := c.SetDistancingPolicies([]DistancingPolicy{{EffectiveFrom: now.Add(-time.Hour), MinDistance: 0}}, now); err != nil {
+		t.Fatal(err)
+	}
+	if c.minDistance != 0 || c.PolicyDue(now) {
+		t.Fatalf("SetDistancingPolicies() did not apply the policy in effect, min distance %d", c.minDistance)
+	}
+
+	// the new policy in effect started before the one applied
+	if err := c.SetDistancingPolicies([]DistancingPolicy{{EffectiveFrom: now.Add(-2 * time.Hour), MinDistance: 4}}, now); err != nil {
+		t.Fatal(err)
+	}
+	if c.minDistance != 4 || c.PolicyDue(now) {
+		t.Errorf("SetDistancingPolicies() with an older policy left min distance %d, want 4", c.minDistance)
+	}
+	replayed, err := Replay(log.StandardLogger(), c.TakeEvents())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayed.minDistance != 4 || replayed.PolicyDue(now) {
+		t.Errorf("Replay() min distance %d, want 4 applied", replayed.minDistance)
+	}
+}
+
+func TestCinema_ExplainSeat(t *testing.T) {
+	c := NewCinema(log.StandardLogger(), 3, 5, 2)
+	_ = c.ReserveSeats([][]int{{0, 0}}, "a", ReserveOptions{})
+	_ = c.ReserveSeats([][]int{{2, 4}}, "b", ReserveOptions{})
+	_ = c.SetUnsellable([][]int{{1, 2}})
+
+	tests := []struct {
+		row, col int
+		group    string
+		want     []ConflictReason
+	}{
+		{row: 3, col: 0, group: "c", want: []ConflictReason{ConflictOutOfRange}},
+		{row: 0, col: 0, group: "a", want: []ConflictReason{ConflictReserved}},
+		{row: 1, col: 2, group: "c", want: []ConflictReason{ConflictUnsellable}},
+		{row: 1, col: 1, group: "a", want: nil},
+		{row: 1, col: 3, group: "c", want: []ConflictReason{ConflictTooClose}},
+		{row: 0, col: 3, group: "c", want: nil},
+	}
+	for _, tt := range tests {
+		explanation := c.ExplainSeat(tt.row, tt.col, tt.group)
+		var got []ConflictReason
+		for _, conflict := range explanation.Conflicts {
+			got = append(got, conflict.Reason)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ExplainSeat(%d, %d, %q) = %v, want %v", tt.row, tt.col, tt.group, got, tt.want)
+		}
+		valid := tt.row < 3 && c.IsValidGroup([][]int{{tt.row, tt.col}}, tt.group)
+		if explanation.Reservable != valid {
+			t.Errorf("ExplainSeat(%d, %d, %q).Reservable = %v, IsValidGroup() = %v", tt.row, tt.col, tt.group, explanation.Reservable, valid)
+		}
+	}
+
+	conflict := c.ExplainSeat(1, 3, "c").Conflicts[0]
+	if conflict.GroupName != "b" || conflict.Distance != 2 || conflict.OtherSeat.Label != "C5" {
+		t.Errorf("ExplainSeat() conflict = %+v", conflict)
+	}
+}
+
+func TestCinema_Summary(t *testing.T) {
+	c := NewCinema(log.StandardLogger(), 2, 5, 0)
+	if err := c.SetDescription(strings.Repeat("a", 101), ""); err == nil {
+		t.Errorf("SetDescription() with a long name succeeded")
+	}
+	_ = c.SetDescription(" Hall 1 ", "ground floor")
+	_ = c.SetUnsellable([][]int{{1, 4}, {1, 3}})
+	if c.HasReservations() {
+		t.Errorf("HasReservations() of an empty cinema")
+	}
+	_ = c.ReserveSeats([][]int{{0, 0}, {0, 1}}, "a", ReserveOptions{})
+
+	replayed, err := Replay(log.StandardLogger(), c.TakeEvents())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Summary{Name: "Hall 1", Description: "ground floor", Rows: 2, Columns: 5, Seats: 8, Reserved: 2}
+	if got := replayed.Summary(); got != want || got.Occupancy() != 0.25 {
+		t.Errorf("Summary() = %+v, want %+v", got, want)
+	}
+	if !replayed.HasReservations() {
+		t.Errorf("HasReservations() = false")
+	}
+}
+
+func TestCinema_ClonePendingEvents(t *testing.T) {
+	c := NewCinema(log.StandardLogger(), 2, 5, 0)
+	_ = c.ReserveSeats([][]int{{0, 0}}, "a", ReserveOptions{})
+	c.TakeEvents()
+	_ = c.ReserveSeats([][]int{{1, 4}}, "b", ReserveOptions{})
+	if err := c.SetDescription("Hall 1", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	// a clone taken before the ConfigUpdated is flushed emits it too
+	clone := c.Clone()
+	_ = clone.ReserveSeats([][]int{{1, 0}}, "c", ReserveOptions{})
+	events := clone.TakeEvents()
+	if len(events) != 3 {
+		t.Fatalf("TakeEvents() of the clone = %d events, want the pending reservation, config update and the new reservation", len(events))
+	}
+	if update, ok := events[1].(ConfigUpdated); !ok || update.Config.Name != "Hall 1" {
+		t.Errorf("second event of the clone = %+v, want the pending ConfigUpdated", events[1])
+	}
+
+	// the events of the clone are its own
+	events = c.TakeEvents()
+	if len(events) != 2 {
+		t.Fatalf("TakeEvents() of the cinema = %d events, want 2", len(events))
+	}
+	if _, ok := events[1].(ConfigUpdated); !ok {
+		t.Errorf("second event of the cinema = %T, want ConfigUpdated", events[1])
+	}
+}
+
+func TestCinema_CloneCopyOnWrite(t *testing.T) {
+	c := NewCinema(log.StandardLogger(), 3, 70, 0)
+	_ = c.ReserveSeats([][]int{{0, 0}, {2, 69}}, "a", ReserveOptions{})
+	clone := c.Clone()
+	if clone.seats.data != c.seats.data {
+		t.Fatalf("Clone() copied the seats before a change")
+	}
+	_ = clone.ReserveSeats([][]int{{1, 10}}, "b", ReserveOptions{})
+	_ = clone.CancelSeats([][]int{{0, 0}})
+	if s := c.seats.at(1, 10); s.status != Available {
+		t.Errorf("reservation of the clone changed the cinema: %+v", s)
+	}
+	if s := c.seats.at(0, 0); s != (Seat{status: Reserved, groupName: "a"}) {
+		t.Errorf("cancellation of the clone changed the cinema: %+v", s)
+	}
+	if s := clone.seats.at(1, 10); s != (Seat{status: Reserved, groupName: "b"}) {
+		t.Errorf("clone seat (1, 10) = %+v", s)
+	}
+	_ = c.ReserveSeats([][]int{{1, 60}}, "c", ReserveOptions{})
+	if s := clone.seats.at(1, 60); s.status != Available {
+		t.Errorf("reservation of the cinema changed the clone: %+v", s)
+	}
+
+	// the ids of the groups without seats are reused
+	_ = c.CancelSeats([][]int{{1, 60}})
+	_ = c.ReserveSeats([][]int{{1, 40}}, "d", ReserveOptions{})
+	if n := len(c.seats.data.names); n != 3 {
+		t.Errorf("%d group ids after reusing the id of c, want 3", n)
+	}
+	if s := c.seats.at(1, 40); s.groupName != "d" {
+		t.Errorf("seat (1, 40) reserved by %q, want d", s.groupName)
+	}
+	if got := c.Summary().Reserved; got != 3 {
+		t.Errorf("Summary().Reserved = %d, want 3", got)
+	}
+}
+
+// reservedCinema returns a cinema of the size with a group every other row and seat
+func reservedCinema(rows, columns int) *Cinema {
+	c := NewCinema(log.StandardLogger(), rows, columns, 0)
+	for i := 0; i < rows; i += 2 {
+		for j := 0; j < columns; j += 4 {
+			_ = c.ReserveSeats([][]int{{i, j}, {i, j + 1}}, fmt.Sprintf("group-%d-%d", i, j), ReserveOptions{})
+		}
+	}
+	c.TakeEvents()
+	return c
+}
+
+func BenchmarkNewCinema(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = NewCinema(log.StandardLogger(), 200, 200, 1)
+	}
+}
+
+func BenchmarkCinema_Clone(b *testing.B) {
+	c := reservedCinema(200, 200)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = c.Clone()
+	}
+}
+
+func BenchmarkCinema_CloneAndReserve(b *testing.B) {
+	c := reservedCinema(200, 200)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = c.Clone().ReserveSeats([][]int{{1, 1}}, "z", ReserveOptions{})
+	}
+}
+
+func BenchmarkCinema_ListAvailableSeats(b *testing.B) {
+	c := reservedCinema(200, 200)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = c.ListAvailableSeats()
+	}
+}
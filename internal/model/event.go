@@ -0,0 +1,218 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Config is the setup of a cinema, everything but its reservations
+type Config struct {
+	Rows              int
+	Columns           int
+	MinDistance       int
+	Separation        SeparationProfile
+	Labels            LabelScheme
+	Adjacency         AdjacencyPolicy
+	Name              string
+	Description       string
+	Policies          []DistancingPolicy
+	PolicyApplied     time.Time
+	Categories        map[[2]int]SeatCategory
+	Unsellable        map[[2]int]bool
+	Currency          string
+	Prices            map[SeatCategory]int64
+	ShowTime          time.Time
+	AccessibleRelease time.Duration
+}
+
+// Event is a change of a cinema. Methods changing a cinema emit events which are
+// collected with TakeEvents, folding them with Replay rebuilds the cinema.
+type Event interface {
+	apply(c *Cinema) error
+}
+
+// CinemaConfigured is the first event of every cinema
+type CinemaConfigured struct {
+	Config Config
+}
+
+// ConfigUpdated replaces the setup, reservations are dropped when the size changes
+type ConfigUpdated struct {
+	Config Config
+}
+
+type SeatsReserved struct {
+	GroupName  string
+	SeatCoords [][]int
+}
+
+type SeatsCancelled struct {
+	SeatCoords [][]int
+}
+
+func (e CinemaConfigured) apply(c *Cinema) error {
+	c.seats = newSeatGrid(e.Config.Rows, e.Config.Columns)
+	c.applyConfig(e.Config)
+	return nil
+}
+
+func (e ConfigUpdated) apply(c *Cinema) error {
+	if c.rows != e.Config.Rows || c.columns != e.Config.Columns {
+		c.seats = newSeatGrid(e.Config.Rows, e.Config.Columns)
+		c.holds = make(map[string]Hold)
+	}
+	c.applyConfig(e.Config)
+	return nil
+}
+
+func (e SeatsReserved) apply(c *Cinema) error {
+	if err := c.validate(e.SeatCoords); err != nil {
+		return err
+	}
+	for _, seat := range e.SeatCoords {
+		c.seats.reserve(seat[Row], seat[Col], e.GroupName)
+	}
+	return nil
+}
+
+func (e SeatsCancelled) apply(c *Cinema) error {
+	if err := c.validate(e.SeatCoords); err != nil {
+		return err
+	}
+	for _, seat := range e.SeatCoords {
+		c.seats.cancel(seat[Row], seat[Col])
+	}
+	c.unhold(e.SeatCoords)
+	return nil
+}
+
+// Config returns a copy of the setup of the cinema
+func (c *Cinema) Config() Config {
+	return Config{
+		Rows:              c.rows,
+		Columns:           c.columns,
+		MinDistance:       c.minDistance,
+		Separation:        c.separation,
+		Labels:            c.labels,
+		Adjacency:         c.adjacency,
+		Name:              c.name,
+		Description:       c.description,
+		Policies:          slices.Clone(c.policies),
+		PolicyApplied:     c.policyApplied,
+		Categories:        maps.Clone(c.categories),
+		Unsellable:        maps.Clone(c.unsellable),
+		Currency:          c.currency,
+		Prices:            maps.Clone(c.prices),
+		ShowTime:          c.showTime,
+		AccessibleRelease: c.accessibleRelease,
+	}
+}
+
+// applyConfig copies the maps so that later changes of the cinema do not alter the event
+func (c *Cinema) applyConfig(config Config) {
+	c.rows = config.Rows
+	c.columns = config.Columns
+	c.minDistance = config.MinDistance
+	c.separation = config.Separation
+	c.labels = config.Labels
+	c.adjacency = config.Adjacency
+	c.name = config.Name
+	c.description = config.Description
+	c.policies = slices.Clone(config.Policies)
+	c.policyApplied = config.PolicyApplied
+	c.categories = maps.Clone(config.Categories)
+	c.unsellable = maps.Clone(config.Unsellable)
+	c.currency = config.Currency
+	c.prices = maps.Clone(config.Prices)
+	c.showTime = config.ShowTime
+	c.accessibleRelease = config.AccessibleRelease
+	if c.categories == nil {
+		c.categories = make(map[[2]int]SeatCategory)
+	}
+	if c.unsellable == nil {
+		c.unsellable = make(map[[2]int]bool)
+	}
+	if c.prices == nil {
+		c.prices = make(map[SeatCategory]int64)
+	}
+}
+
+// configChanged notes that the setup changed, the event is emitted with the setup as it is
+// before the next seat change so that several setters make a single event
+func (c *Cinema) configChanged() {
+	c.configDirty = true
+}
+
+func (c *Cinema) flushConfig() {
+	if !c.configDirty {
+		return
+	}
+	c.configDirty = false
+	if !c.configured {
+		c.configured = true
+		c.events = append(c.events, CinemaConfigured{Config: c.Config()})
+		return
+	}
+	c.events = append(c.events, ConfigUpdated{Config: c.Config()})
+}
+
+func (c *Cinema) emit(e Event) {
+	c.flushConfig()
+	c.events = append(c.events, e)
+}
+
+// emitReservations emits the reservations of the seats, one event per group
+func (c *Cinema) emitReservations(seatCoords [][]int) {
+	groups := make(map[string][][]int)
+	for _, seat := range seatCoords {
+		name := c.seats.at(seat[Row], seat[Col]).groupName
+		groups[name] = append(groups[name], seat)
+	}
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		c.emit(SeatsReserved{GroupName: name, SeatCoords: groups[name]})
+	}
+}
+
+// TakeEvents returns the events emitted since the last call and forgets them
+func (c *Cinema) TakeEvents() []Event {
+	c.flushConfig()
+	events := c.events
+	c.events = nil
+	return events
+}
+
+// Replay rebuilds a cinema by folding its events, the first one must be CinemaConfigured
+func Replay(l *log.Logger, events []Event) (*Cinema, error) {
+	if len(events) == 0 {
+		return nil, errors.New("no event to replay")
+	}
+	if _, ok := events[0].(CinemaConfigured); !ok {
+		return nil, fmt.Errorf("first event must be CinemaConfigured, got %T", events[0])
+	}
+	c := &Cinema{logger: l, configured: true}
+	for _, e := range events {
+		if err := c.Apply(e); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// Apply changes the cinema by an event without emitting it
+func (c *Cinema) Apply(e Event) error {
+	if err := e.apply(c); err != nil {
+		return fmt.Errorf("apply %T: %w", e, err)
+	}
+	return nil
+}
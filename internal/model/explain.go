@@ -0,0 +1,114 @@
+package model
+
+import (
+	"github.com/t3201v/seat-arrangement/gen/cinema"
+	"github.com/t3201v/seat-arrangement/internal/helper"
+)
+
+// ConflictReason is why a seat cannot be reserved
+type ConflictReason int
+
+const (
+	ConflictOutOfRange ConflictReason = iota + 1
+	ConflictReserved
+	ConflictUnsellable
+	ConflictTooClose
+)
+
+var conflictReasonNames = []string{"", "out_of_range", "reserved", "unsellable", "too_close"}
+
+func (r ConflictReason) String() string {
+	if r < ConflictOutOfRange || r > ConflictTooClose {
+		return "unknown"
+	}
+	return conflictReasonNames[r]
+}
+
+// SeatConflict is a reason why a seat cannot be reserved by a group
+type SeatConflict struct {
+	Reason     ConflictReason
+	SeatCoords []int  // seat of the group
+	GroupName  string // group of the seat reserved or too close
+	Other      []int  // reserved seat too close
+	Distance   int    // Manhattan distance to the reserved seat too close
+
+	Seat, OtherSeat *cinema.Seat // SeatCoords and Other with their labels, set by ExplainSeat
+}
+
+// SeatExplanation tells whether a seat can be reserved by a group and why not
+type SeatExplanation struct {
+	Seat       *cinema.Seat
+	Reservable bool
+	Conflicts  []SeatConflict
+}
+
+// ExplainSeat lists every reason why the group cannot reserve the seat, following IsValidGroup
+func (c *Cinema) ExplainSeat(row, col int, groupName string) SeatExplanation {
+	seatCoords := [][]int{{row, col}}
+	explanation := SeatExplanation{Seat: &cinema.Seat{Row: int32(row), Column: int32(col)}}
+	if err := c.validate(seatCoords); err != nil {
+		explanation.Conflicts = []SeatConflict{{Reason: ConflictOutOfRange, SeatCoords: seatCoords[0], Seat: explanation.Seat}}
+		return explanation
+	}
+	explanation.Seat.Label = c.SeatLabel(row, col)
+	explanation.Seat.Category = cinema.SeatCategory(c.SeatCategoryOf(row, col))
+	explanation.Conflicts = c.conflicts(seatCoords, groupName, 0)
+	for i, conflict := range explanation.Conflicts {
+		explanation.Conflicts[i].Seat = explanation.Seat
+		if conflict.Other != nil {
+			other, _ := c.ToPbSeats([][]int{conflict.Other})
+			explanation.Conflicts[i].OtherSeat = other[0]
+		}
+	}
+	explanation.Reservable = len(explanation.Conflicts) == 0
+	return explanation
+}
+
+// conflicts lists why the group cannot reserve the valid seats, up to limit conflicts when positive
+func (c *Cinema) conflicts(seatCoords [][]int, groupName string, limit int) []SeatConflict {
+	var result []SeatConflict
+	full := func() bool { return limit > 0 && len(result) >= limit }
+
+	// Check if the group contains any reserved or unsellable seats
+	for _, seat := range seatCoords {
+		if s := c.seats.at(seat[Row], seat[Col]); s.status == Reserved {
+			result = append(result, SeatConflict{Reason: ConflictReserved, SeatCoords: seat, GroupName: s.groupName})
+			if full() {
+				return result
+			}
+		}
+		if c.IsUnsellable(seat[Row], seat[Col]) {
+			result = append(result, SeatConflict{Reason: ConflictUnsellable, SeatCoords: seat})
+			if full() {
+				return result
+			}
+		}
+	}
+
+	// Check if the group satisfies the minimum distance rule
+	for i := 0; i < c.rows; i++ {
+		for j := 0; j < c.columns; j++ {
+			if c.seats.at(i, j).status != Reserved || c.seats.at(i, j).groupName == groupName {
+				continue
+			}
+			// check minimum distance for different groups of seats
+			for _, seat := range seatCoords {
+				// the seat itself is already reported as reserved
+				if (i == seat[Row] && j == seat[Col]) || !c.tooClose(i, j, seat[Row], seat[Col]) {
+					continue
+				}
+				result = append(result, SeatConflict{
+					Reason:     ConflictTooClose,
+					SeatCoords: seat,
+					GroupName:  c.seats.at(i, j).groupName,
+					Other:      []int{i, j},
+					Distance:   helper.ManhattanDistance(i, j, seat[Row], seat[Col]),
+				})
+				if full() {
+					return result
+				}
+			}
+		}
+	}
+	return result
+}
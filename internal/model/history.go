@@ -0,0 +1,115 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// SeatChange is a seat whose reservation differs between two versions of a cinema
+type SeatChange struct {
+	Row       int
+	Col       int
+	Label     string
+	From      SeatState // StateAvailable or StateReserved
+	FromGroup string
+	To        SeatState
+	ToGroup   string
+}
+
+func (c *Cinema) reservation(row, col int) (SeatState, string) {
+	if c.seats.at(row, col).status != Reserved {
+		return StateAvailable, ""
+	}
+	return StateReserved, c.seats.at(row, col).groupName
+}
+
+// Diff lists the seats whose reservation changed from c to other, both must have the same size
+func (c *Cinema) Diff(other *Cinema) ([]SeatChange, error) {
+	if c.rows != other.rows || c.columns != other.columns {
+		return nil, fmt.Errorf("cannot compare a %dx%d cinema with a %dx%d one", c.rows, c.columns, other.rows, other.columns)
+	}
+	changes := make([]SeatChange, 0)
+	for i := 0; i < c.rows; i++ {
+		for j := 0; j < c.columns; j++ {
+			from, fromGroup := c.reservation(i, j)
+			to, toGroup := other.reservation(i, j)
+			if from == to && fromGroup == toGroup {
+				continue
+			}
+			changes = append(changes, SeatChange{
+				Row:       i,
+				Col:       j,
+				Label:     other.SeatLabel(i, j),
+				From:      from,
+				FromGroup: fromGroup,
+				To:        to,
+				ToGroup:   toGroup,
+			})
+		}
+	}
+	return changes, nil
+}
+
+// RevertTo restores the reservations of an older version of the cinema which have been
+// cancelled since. Reservations made since are kept unless discardNewer is set, the restored
+// groups must respect the current minimum distance to them. It returns the changed seats.
+func (c *Cinema) RevertTo(old *Cinema, discardNewer bool) ([]SeatChange, error) {
+	if c.rows != old.rows || c.columns != old.columns {
+		return nil, errors.New("cannot revert to a version of another size")
+	}
+	work := c.Clone()
+	restore := make(map[string][][]int)
+	for i := 0; i < c.rows; i++ {
+		for j := 0; j < c.columns; j++ {
+			state, group := work.reservation(i, j)
+			oldState, oldGroup := old.reservation(i, j)
+			if state == oldState && group == oldGroup {
+				continue
+			}
+			if state == StateReserved && discardNewer {
+				work.seats.cancel(i, j)
+			}
+			if oldState == StateReserved {
+				restore[oldGroup] = append(restore[oldGroup], []int{i, j})
+			}
+		}
+	}
+
+	groups := make([]string, 0, len(restore))
+	for group := range restore {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+	for _, group := range groups {
+		seats := restore[group]
+		if !work.IsValidGroup(seats, group) {
+			return nil, fmt.Errorf("cannot restore the seats of group %q, they are taken or too close to another group", group)
+		}
+		for _, seat := range seats {
+			work.seats.reserve(seat[Row], seat[Col], group)
+		}
+	}
+
+	changes, err := c.Diff(work)
+	if err != nil {
+		return nil, err
+	}
+	c.seats = work.seats
+	cancelled := make([][]int, 0)
+	reserved := make([][]int, 0)
+	for _, change := range changes {
+		if change.From == StateReserved {
+			cancelled = append(cancelled, []int{change.Row, change.Col})
+		}
+		if change.To == StateReserved {
+			reserved = append(reserved, []int{change.Row, change.Col})
+		}
+	}
+	if len(cancelled) > 0 {
+		c.unhold(cancelled)
+		c.emit(SeatsCancelled{SeatCoords: cancelled})
+	}
+	c.emitReservations(reserved)
+	return changes, nil
+}
@@ -0,0 +1,152 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/t3201v/seat-arrangement/gen/cinema"
+)
+
+type RowLabelStyle int
+
+const (
+	RowLetters RowLabelStyle = iota // A, B, ..., Z, AA, AB...
+	RowNumbers                      // 1, 2, 3...
+)
+
+type SeatNumbering int
+
+const (
+	LeftToRight SeatNumbering = iota
+	RightToLeft
+	FromCenter // 1, 2, 3... from the center on each side, prefixed by L or R
+	OddEven    // from the center, odd numbers on the left side and even ones on the right
+)
+
+// LabelScheme describes how rows and seats are labeled, the zero value labels seats like "A1"
+type LabelScheme struct {
+	RowStyle    RowLabelStyle
+	SkipLetters string // letters not used for rows
+	Numbering   SeatNumbering
+}
+
+func (s LabelScheme) alphabet() string {
+	var sb strings.Builder
+	for r := 'A'; r <= 'Z'; r++ {
+		if !strings.ContainsRune(strings.ToUpper(s.SkipLetters), r) {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// SetLabelScheme changes how rows and seats are labeled
+func (c *Cinema) SetLabelScheme(scheme LabelScheme) error {
+	if scheme.RowStyle != RowLetters && scheme.RowStyle != RowNumbers {
+		return fmt.Errorf("unknown row label style %d", scheme.RowStyle)
+	}
+	if scheme.Numbering < LeftToRight || scheme.Numbering > OddEven {
+		return fmt.Errorf("unknown seat numbering %d", scheme.Numbering)
+	}
+	for _, r := range strings.ToUpper(scheme.SkipLetters) {
+		if r < 'A' || r > 'Z' {
+			return fmt.Errorf("skip letters must be letters, got %q", r)
+		}
+	}
+	if scheme.RowStyle == RowLetters && len(scheme.alphabet()) < 2 {
+		return errors.New("at least two letters are needed to label rows")
+	}
+	c.labels = scheme
+	c.configChanged()
+	return nil
+}
+
+// LabelScheme returns how rows and seats are labeled
+func (c *Cinema) LabelScheme() LabelScheme {
+	return c.labels
+}
+
+// RowLabel names a row, with letters like spreadsheet columns by default: A..Z, AA, AB...
+func (c *Cinema) RowLabel(row int) string {
+	if c.labels.RowStyle == RowNumbers {
+		return strconv.Itoa(row + 1)
+	}
+	alphabet := c.labels.alphabet()
+	base := len(alphabet)
+	label := ""
+	for n := row + 1; n > 0; n = (n - 1) / base {
+		label = string(alphabet[(n-1)%base]) + label
+	}
+	return label
+}
+
+// seatNumber returns the label of a seat within its row
+func (c *Cinema) seatNumber(col int) string {
+	center := c.columns / 2 // with an odd number of seats the middle one is on the right side
+	switch c.labels.Numbering {
+	case RightToLeft:
+		return strconv.Itoa(c.columns - col)
+	case FromCenter:
+		if col < center {
+			return "L" + strconv.Itoa(center-col)
+		}
+		return "R" + strconv.Itoa(col-center+1)
+	case OddEven:
+		if col < center {
+			return strconv.Itoa(2*(center-col) - 1)
+		}
+		return strconv.Itoa(2 * (col - center + 1))
+	default:
+		return strconv.Itoa(col + 1)
+	}
+}
+
+// SeatLabel names a seat by its row and its number, e.g. "F12", or "6-12" when rows are numbered
+func (c *Cinema) SeatLabel(row, col int) string {
+	if c.labels.RowStyle == RowNumbers {
+		return c.RowLabel(row) + "-" + c.seatNumber(col)
+	}
+	return c.RowLabel(row) + c.seatNumber(col)
+}
+
+func normalizeLabel(label string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(label), ""))
+}
+
+// ParseSeatLabel returns the coordinates of the seat with the label, ignoring case and spaces
+func (c *Cinema) ParseSeatLabel(label string) (int, int, error) {
+	want := normalizeLabel(label)
+	for i := 0; i < c.rows; i++ {
+		if !strings.HasPrefix(want, c.RowLabel(i)) {
+			continue
+		}
+		for j := 0; j < c.columns; j++ {
+			if c.SeatLabel(i, j) == want {
+				return i, j, nil
+			}
+		}
+	}
+	return 0, 0, fmt.Errorf("unknown seat label %q", label)
+}
+
+// FromPbSeats returns the coordinates of the seats, resolving the labels of the seats that have one
+func (c *Cinema) FromPbSeats(pbSeats []*cinema.Seat) ([][]int, error) {
+	seats := make([][]int, 0, len(pbSeats))
+	for _, seat := range pbSeats {
+		if seat == nil {
+			return nil, errors.New("malformed seat data")
+		}
+		if seat.Label == "" {
+			seats = append(seats, []int{int(seat.Row), int(seat.Column)})
+			continue
+		}
+		row, col, err := c.ParseSeatLabel(seat.Label)
+		if err != nil {
+			return nil, err
+		}
+		seats = append(seats, []int{row, col})
+	}
+	return seats, nil
+}
@@ -0,0 +1,480 @@
+package model
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+// LayoutVersion is the version of the layout format written by Export, see docs/layout-format.md
+const LayoutVersion = 1
+
+type LayoutFormat int
+
+const (
+	FormatJSON LayoutFormat = iota
+	FormatYAML
+	FormatCSV
+)
+
+var categoryNames = [SeatCategoryEnd]string{"standard", "premium", "vip", "wheelchair", "companion"}
+
+func (s SeatCategory) String() string {
+	if s < Standard || s >= SeatCategoryEnd {
+		return fmt.Sprintf("SeatCategory(%d)", int(s))
+	}
+	return categoryNames[s]
+}
+
+// ParseSeatCategory returns the category of the name, e.g. "vip"
+func ParseSeatCategory(name string) (SeatCategory, error) {
+	for i, n := range categoryNames {
+		if strings.EqualFold(n, name) {
+			return SeatCategory(i), nil
+		}
+	}
+	return Standard, fmt.Errorf("unknown seat category %q", name)
+}
+
+var (
+	rowStyleNames  = []string{"letters", "numbers"}
+	numberingNames = []string{"left_to_right", "right_to_left", "from_center", "odd_even"}
+)
+
+func parseName(names []string, name, what string) (int, error) {
+	if name == "" {
+		return 0, nil
+	}
+	for i, n := range names {
+		if strings.EqualFold(n, name) {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown %s %q", what, name)
+}
+
+// LayoutSeparation is the separation profile of a layout
+type LayoutSeparation struct {
+	Horizontal int      `json:"horizontal,omitempty" yaml:"horizontal,omitempty"`
+	Vertical   int      `json:"vertical,omitempty" yaml:"vertical,omitempty"`
+	Diagonal   int      `json:"diagonal,omitempty" yaml:"diagonal,omitempty"`
+	Mask       []string `json:"mask,omitempty" yaml:"mask,omitempty"`
+}
+
+func toLayoutSeparation(profile SeparationProfile) *LayoutSeparation {
+	if profile.IsZero() {
+		return nil
+	}
+	return &LayoutSeparation{
+		Horizontal: profile.Horizontal,
+		Vertical:   profile.Vertical,
+		Diagonal:   profile.Diagonal,
+		Mask:       profile.Mask,
+	}
+}
+
+func (l *LayoutSeparation) profile() SeparationProfile {
+	if l == nil {
+		return SeparationProfile{}
+	}
+	return SeparationProfile{Horizontal: l.Horizontal, Vertical: l.Vertical, Diagonal: l.Diagonal, Mask: l.Mask}
+}
+
+// LayoutPolicy is a scheduled distancing policy of a layout
+type LayoutPolicy struct {
+	EffectiveFrom time.Time         `json:"effective_from" yaml:"effective_from"`
+	MinDistance   int               `json:"min_distance" yaml:"min_distance"`
+	Separation    *LayoutSeparation `json:"separation,omitempty" yaml:"separation,omitempty"`
+}
+
+// Layout is the serializable description of a cinema and optionally of its reservations
+type Layout struct {
+	Version           int                 `json:"version" yaml:"version"`
+	Name              string              `json:"name,omitempty" yaml:"name,omitempty"`
+	Description       string              `json:"description,omitempty" yaml:"description,omitempty"`
+	Rows              int                 `json:"rows" yaml:"rows"`
+	Columns           int                 `json:"columns" yaml:"columns"`
+	MinDistance       int                 `json:"min_distance" yaml:"min_distance"`
+	Separation        *LayoutSeparation   `json:"separation,omitempty" yaml:"separation,omitempty"`
+	Policies          []LayoutPolicy      `json:"policies,omitempty" yaml:"policies,omitempty"`
+	Labels            *LayoutLabels       `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Adjacency         string              `json:"adjacency,omitempty" yaml:"adjacency,omitempty"`
+	AllowSplit        bool                `json:"allow_split,omitempty" yaml:"allow_split,omitempty"`
+	Unsellable        [][2]int            `json:"unsellable,omitempty" yaml:"unsellable,omitempty,flow"`
+	Categories        []LayoutCategory    `json:"categories,omitempty" yaml:"categories,omitempty"`
+	Currency          string              `json:"currency,omitempty" yaml:"currency,omitempty"`
+	Prices            map[string]int64    `json:"prices,omitempty" yaml:"prices,omitempty"`
+	ShowTime          *time.Time          `json:"show_time,omitempty" yaml:"show_time,omitempty"`
+	AccessibleRelease string              `json:"accessible_release,omitempty" yaml:"accessible_release,omitempty"`
+	Reservations      []LayoutReservation `json:"reservations,omitempty" yaml:"reservations,omitempty"`
+}
+
+type LayoutLabels struct {
+	RowStyle    string `json:"row_style,omitempty" yaml:"row_style,omitempty"`
+	SkipLetters string `json:"skip_letters,omitempty" yaml:"skip_letters,omitempty"`
+	Numbering   string `json:"numbering,omitempty" yaml:"numbering,omitempty"`
+}
+
+type LayoutCategory struct {
+	Category string   `json:"category" yaml:"category"`
+	Seats    [][2]int `json:"seats" yaml:"seats,flow"`
+}
+
+type LayoutReservation struct {
+	Group      string   `json:"group" yaml:"group"`
+	Seats      [][2]int `json:"seats" yaml:"seats,flow"`
+	Accessible bool     `json:"accessible,omitempty" yaml:"accessible,omitempty"` // the group needs wheelchair spaces
+}
+
+// Export describes the cinema, with its reservations if asked
+func (c *Cinema) Export(withReservations bool) *Layout {
+	layout := &Layout{
+		Version:     LayoutVersion,
+		Name:        c.name,
+		Description: c.description,
+		Rows:        c.rows,
+		Columns:     c.columns,
+		MinDistance: c.minDistance,
+		Currency:    c.currency,
+	}
+	if c.labels != (LabelScheme{}) {
+		layout.Labels = &LayoutLabels{
+			RowStyle:    rowStyleNames[c.labels.RowStyle],
+			SkipLetters: c.labels.SkipLetters,
+			Numbering:   numberingNames[c.labels.Numbering],
+		}
+	}
+	layout.Separation = toLayoutSeparation(c.separation)
+	for _, policy := range c.policies {
+		layout.Policies = append(layout.Policies, LayoutPolicy{
+			EffectiveFrom: policy.EffectiveFrom,
+			MinDistance:   policy.MinDistance,
+			Separation:    toLayoutSeparation(policy.Separation),
+		})
+	}
+	if c.adjacency != (AdjacencyPolicy{}) {
+		layout.Adjacency = c.adjacency.Rule.String()
+		layout.AllowSplit = c.adjacency.AllowSplit
+	}
+	if len(c.prices) > 0 {
+		layout.Prices = make(map[string]int64, len(c.prices))
+		for category, price := range c.prices {
+			layout.Prices[category.String()] = price
+		}
+	}
+	if !c.showTime.IsZero() {
+		showTime := c.showTime
+		layout.ShowTime = &showTime
+		layout.AccessibleRelease = c.accessibleRelease.String()
+	}
+
+	// walk the seats in order so that exports are stable
+	categories := make(map[SeatCategory]int)
+	groups := make(map[string]int)
+	for i := 0; i < c.rows; i++ {
+		for j := 0; j < c.columns; j++ {
+			if c.IsUnsellable(i, j) {
+				layout.Unsellable = append(layout.Unsellable, [2]int{i, j})
+			}
+			if category := c.SeatCategoryOf(i, j); category != Standard {
+				idx, ok := categories[category]
+				if !ok {
+					idx = len(layout.Categories)
+					categories[category] = idx
+					layout.Categories = append(layout.Categories, LayoutCategory{Category: category.String()})
+				}
+				layout.Categories[idx].Seats = append(layout.Categories[idx].Seats, [2]int{i, j})
+			}
+			if withReservations && c.seats.at(i, j).status == Reserved {
+				name := c.seats.at(i, j).groupName
+				idx, ok := groups[name]
+				if !ok {
+					idx = len(layout.Reservations)
+					groups[name] = idx
+					layout.Reservations = append(layout.Reservations, LayoutReservation{Group: name})
+				}
+				layout.Reservations[idx].Seats = append(layout.Reservations[idx].Seats, [2]int{i, j})
+				// only groups which need them get wheelchair spaces before they are released
+				if c.SeatCategoryOf(i, j) == Wheelchair {
+					layout.Reservations[idx].Accessible = true
+				}
+			}
+		}
+	}
+	return layout
+}
+
+func toSeatCoords(seats [][2]int) [][]int {
+	result := make([][]int, 0, len(seats))
+	for _, seat := range seats {
+		result = append(result, []int{seat[Row], seat[Col]})
+	}
+	return result
+}
+
+// Import builds a cinema from its layout, reservations must follow the same rules as
+// ReserveSeats: distance, accessibility and adjacency
+func Import(l *log.Logger, layout *Layout) (*Cinema, error) {
+	if layout.Version != LayoutVersion {
+		return nil, fmt.Errorf("unsupported layout version %d, want %d", layout.Version, LayoutVersion)
+	}
+	if err := ValidateConfig(layout.Rows, layout.Columns, layout.MinDistance); err != nil {
+		return nil, err
+	}
+	c := NewCinema(l, layout.Rows, layout.Columns, layout.MinDistance)
+	if layout.Name != "" || layout.Description != "" {
+		if err := c.SetDescription(layout.Name, layout.Description); err != nil {
+			return nil, err
+		}
+	}
+
+	if layout.Labels != nil {
+		rowStyle, err := parseName(rowStyleNames, layout.Labels.RowStyle, "row style")
+		if err != nil {
+			return nil, err
+		}
+		numbering, err := parseName(numberingNames, layout.Labels.Numbering, "numbering")
+		if err != nil {
+			return nil, err
+		}
+		err = c.SetLabelScheme(LabelScheme{
+			RowStyle:    RowLabelStyle(rowStyle),
+			SkipLetters: layout.Labels.SkipLetters,
+			Numbering:   SeatNumbering(numbering),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if layout.Separation != nil {
+		if err := c.SetSeparation(layout.Separation.profile()); err != nil {
+			return nil, err
+		}
+	}
+	if len(layout.Policies) > 0 {
+		policies := make([]DistancingPolicy, 0, len(layout.Policies))
+		for _, policy := range layout.Policies {
+			policies = append(policies, DistancingPolicy{
+				EffectiveFrom: policy.EffectiveFrom,
+				MinDistance:   policy.MinDistance,
+				Separation:    policy.Separation.profile(),
+			})
+		}
+		if err := c.SetDistancingPolicies(policies, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+	adjacency, err := parseName(adjacencyNames, layout.Adjacency, "adjacency")
+	if err != nil {
+		return nil, err
+	}
+	err = c.SetAdjacencyPolicy(AdjacencyPolicy{Rule: Adjacency(adjacency), AllowSplit: layout.AllowSplit})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.SetUnsellable(toSeatCoords(layout.Unsellable)); err != nil {
+		return nil, err
+	}
+	for _, category := range layout.Categories {
+		seatCategory, err := ParseSeatCategory(category.Category)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.SetSeatCategory(toSeatCoords(category.Seats), seatCategory); err != nil {
+			return nil, err
+		}
+	}
+	prices := make(map[SeatCategory]int64, len(layout.Prices))
+	for name, price := range layout.Prices {
+		category, err := ParseSeatCategory(name)
+		if err != nil {
+			return nil, err
+		}
+		prices[category] = price
+	}
+	if err := c.SetPrices(layout.Currency, prices); err != nil {
+		return nil, err
+	}
+	if layout.ShowTime != nil {
+		var release time.Duration
+		if layout.AccessibleRelease != "" {
+			var err error
+			release, err = time.ParseDuration(layout.AccessibleRelease)
+			if err != nil {
+				return nil, fmt.Errorf("malformed accessible release: %w", err)
+			}
+		}
+		if err := c.SetShowTime(*layout.ShowTime, release); err != nil {
+			return nil, err
+		}
+	}
+	for _, reservation := range layout.Reservations {
+		seats := toSeatCoords(reservation.Seats)
+		if err := c.checkReservation(seats, reservation.Group, ReserveOptions{Accessible: reservation.Accessible}); err != nil {
+			return nil, fmt.Errorf("seats of group %q cannot be reserved: %w", reservation.Group, err)
+		}
+		for _, seat := range seats {
+			c.seats.reserve(seat[Row], seat[Col], reservation.Group)
+		}
+		c.emit(SeatsReserved{GroupName: reservation.Group, SeatCoords: seats})
+	}
+	return c, nil
+}
+
+// EncodeLayout serializes the layout. The CSV format is a grid with one cell per seat
+// holding its category code (S, P, V, W, C), prefixed by X when unsellable, followed by @
+// and the group name when reserved, e.g. "V@smith" or "XV". It does not hold the other
+// settings, groups holding a wheelchair space are taken as accessible.
+func EncodeLayout(layout *Layout, format LayoutFormat) ([]byte, error) {
+	switch format {
+	case FormatJSON:
+		return json.MarshalIndent(layout, "", "  ")
+	case FormatYAML:
+		return yaml.Marshal(layout)
+	case FormatCSV:
+		return encodeCSV(layout)
+	default:
+		return nil, fmt.Errorf("unknown layout format %d", format)
+	}
+}
+
+// DecodeLayout parses a layout, minDistance is only used by the CSV format which does not hold it
+func DecodeLayout(data []byte, format LayoutFormat, minDistance int) (*Layout, error) {
+	var layout Layout
+	switch format {
+	case FormatJSON:
+		if err := json.Unmarshal(data, &layout); err != nil {
+			return nil, err
+		}
+	case FormatYAML:
+		if err := yaml.Unmarshal(data, &layout); err != nil {
+			return nil, err
+		}
+	case FormatCSV:
+		return decodeCSV(data, minDistance)
+	default:
+		return nil, fmt.Errorf("unknown layout format %d", format)
+	}
+	return &layout, nil
+}
+
+func encodeCSV(layout *Layout) ([]byte, error) {
+	inRange := func(seats [][2]int) error {
+		for _, seat := range seats {
+			if seat[Row] < 0 || seat[Row] >= layout.Rows || seat[Col] < 0 || seat[Col] >= layout.Columns {
+				return fmt.Errorf("seat (%d, %d) is out of the grid", seat[Row], seat[Col])
+			}
+		}
+		return nil
+	}
+	seatLists := [][][2]int{layout.Unsellable}
+	for _, category := range layout.Categories {
+		seatLists = append(seatLists, category.Seats)
+	}
+	for _, reservation := range layout.Reservations {
+		seatLists = append(seatLists, reservation.Seats)
+	}
+	for _, seats := range seatLists {
+		if err := inRange(seats); err != nil {
+			return nil, err
+		}
+	}
+
+	grid := make([][]string, layout.Rows)
+	for i := range grid {
+		grid[i] = make([]string, layout.Columns)
+		for j := range grid[i] {
+			grid[i][j] = string(categoryCodes[Standard])
+		}
+	}
+	for _, category := range layout.Categories {
+		seatCategory, err := ParseSeatCategory(category.Category)
+		if err != nil {
+			return nil, err
+		}
+		for _, seat := range category.Seats {
+			grid[seat[Row]][seat[Col]] = string(categoryCodes[seatCategory])
+		}
+	}
+	for _, seat := range layout.Unsellable {
+		grid[seat[Row]][seat[Col]] = "X" + grid[seat[Row]][seat[Col]]
+	}
+	for _, reservation := range layout.Reservations {
+		for _, seat := range reservation.Seats {
+			grid[seat[Row]][seat[Col]] += "@" + reservation.Group
+		}
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.WriteAll(grid); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeCSV(data []byte, minDistance int) (*Layout, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.TrimLeadingSpace = true
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.New("empty grid")
+	}
+
+	layout := &Layout{
+		Version:     LayoutVersion,
+		Rows:        len(records),
+		Columns:     len(records[0]),
+		MinDistance: minDistance,
+	}
+	categories := make(map[SeatCategory]int)
+	groups := make(map[string]int)
+	for i, record := range records {
+		for j, cell := range record {
+			code, group, reserved := strings.Cut(strings.TrimSpace(cell), "@")
+			code = strings.ToUpper(code)
+			if unsellable, ok := strings.CutPrefix(code, "X"); ok {
+				layout.Unsellable = append(layout.Unsellable, [2]int{i, j})
+				code = unsellable
+			}
+			switch code {
+			case "", string(categoryCodes[Standard]):
+			default:
+				category := strings.IndexByte(string(categoryCodes[:]), code[0])
+				if len(code) != 1 || category < 0 {
+					return nil, fmt.Errorf("unknown cell %q at (%d, %d)", cell, i, j)
+				}
+				idx, ok := categories[SeatCategory(category)]
+				if !ok {
+					idx = len(layout.Categories)
+					categories[SeatCategory(category)] = idx
+					layout.Categories = append(layout.Categories, LayoutCategory{Category: SeatCategory(category).String()})
+				}
+				layout.Categories[idx].Seats = append(layout.Categories[idx].Seats, [2]int{i, j})
+			}
+			if reserved {
+				idx, ok := groups[group]
+				if !ok {
+					idx = len(layout.Reservations)
+					groups[group] = idx
+					layout.Reservations = append(layout.Reservations, LayoutReservation{Group: group})
+				}
+				layout.Reservations[idx].Seats = append(layout.Reservations[idx].Seats, [2]int{i, j})
+				if code == string(categoryCodes[Wheelchair]) {
+					layout.Reservations[idx].Accessible = true
+				}
+			}
+		}
+	}
+	return layout, nil
+}
@@ -0,0 +1,339 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/t3201v/seat-arrangement/gen/cinema"
+)
+
+type PlanObjective int
+
+const (
+	MaximizePeople PlanObjective = iota
+	MaximizeParties
+)
+
+// Party is a group of people to be seated together
+type Party struct {
+	GroupName string
+	Size      int
+	Priority  int // parties with a higher priority are seated first
+}
+
+// PlanOptions tunes the seating planner
+type PlanOptions struct {
+	Objective PlanObjective
+	Exact     bool          // search for the best plan after the greedy one
+	Timeout   time.Duration // bounds the whole planning, required by the exact search
+}
+
+// maxCandidates bounds the blocks tried for every party, the first ones in row order are kept
+const maxCandidates = 200
+
+// PartyAssignment is the seats planned for a party
+type PartyAssignment struct {
+	GroupName  string
+	SeatCoords [][]int
+	Seats      []*cinema.Seat // SeatCoords with their labels
+}
+
+// SeatingPlan is the result of PlanSeating
+type SeatingPlan struct {
+	Assignments   []PartyAssignment
+	Unseated      []string
+	SeatedPeople  int
+	SeatedParties int
+	Optimal       bool // the exact search completed before the timeout
+}
+
+// PlanSeating assigns seats to as many parties as possible without changing the cinema.
+// Every party sits in a block of consecutive seats of a row, or in a block of connected
+// seats when it does not fit in a row. A greedy pass places parties by priority, picking
+// the block that makes the fewest seats unreservable, among the first maxCandidates blocks;
+// with opts.Exact a branch and bound search then looks for a better plan. Both stop at
+// opts.Timeout, leaving the parties not seated yet unseated.
+func (c *Cinema) PlanSeating(parties []Party, opts PlanOptions) (*SeatingPlan, error) {
+	names := make(map[string]bool, len(parties))
+	for _, p := range parties {
+		if p.Size <= 0 {
+			return nil, fmt.Errorf("size of party %q must be positive", p.GroupName)
+		}
+		if names[p.GroupName] {
+			return nil, fmt.Errorf("party %q is listed twice", p.GroupName)
+		}
+		names[p.GroupName] = true
+		if c.hasGroup(p.GroupName) {
+			return nil, fmt.Errorf("group %q already has reserved seats", p.GroupName)
+		}
+	}
+	if opts.Exact && opts.Timeout <= 0 {
+		return nil, errors.New("exact search needs a positive timeout")
+	}
+
+	order := make([]int, len(parties))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		pa, pb := parties[order[a]], parties[order[b]]
+		if pa.Priority != pb.Priority {
+			return pa.Priority > pb.Priority
+		}
+		if opts.Objective == MaximizeParties {
+			return pa.Size < pb.Size
+		}
+		return pa.Size > pb.Size
+	})
+
+	p := &planner{
+		cinema:    c.Clone(),
+		parties:   parties,
+		order:     order,
+		objective: opts.Objective,
+		current:   make([][][]int, len(parties)),
+	}
+	if opts.Timeout > 0 {
+		p.deadline = time.Now().Add(opts.Timeout)
+	}
+	p.greedy()
+	optimal := false
+	if opts.Exact {
+		p.search(0, planScore{})
+		optimal = !p.timedOut
+	}
+	return p.plan(optimal)
+}
+
+func (c *Cinema) hasGroup(groupName string) bool {
+	for i := 0; i < c.rows; i++ {
+		for j := 0; j < c.columns; j++ {
+			if c.seats.at(i, j).status == Reserved && c.seats.at(i, j).groupName == groupName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// planScore compares plans, first by the objective then by the priorities seated
+type planScore struct {
+	value    int
+	priority int
+}
+
+func (s planScore) less(o planScore) bool {
+	if s.value != o.value {
+		return s.value < o.value
+	}
+	return s.priority < o.priority
+}
+
+type planner struct {
+	cinema    *Cinema
+	parties   []Party
+	order     []int
+	objective PlanObjective
+
+	current   [][][]int // seats of every party in the plan being built, nil if unseated
+	best      [][][]int
+	bestScore planScore
+
+	deadline time.Time // none if zero
+	timedOut bool
+}
+
+// expired tells if the deadline has passed, it stays so once it did
+func (p *planner) expired() bool {
+	if !p.timedOut && !p.deadline.IsZero() && time.Now().After(p.deadline) {
+		p.timedOut = true
+	}
+	return p.timedOut
+}
+
+func (p *planner) gain(party Party) planScore {
+	if p.objective == MaximizeParties {
+		return planScore{value: 1, priority: party.Priority}
+	}
+	return planScore{value: party.Size, priority: party.Priority}
+}
+
+func (p *planner) greedy() {
+	score := planScore{}
+	for _, idx := range p.order {
+		if p.expired() {
+			break
+		}
+		party := p.parties[idx]
+		var best [][]int
+		bestDamage := 0
+		for _, candidate := range p.candidates(party) {
+			if p.expired() {
+				break
+			}
+			damage := p.cinema.damage(candidate)
+			if best == nil || damage < bestDamage {
+				best, bestDamage = candidate, damage
+			}
+		}
+		if best == nil {
+			continue
+		}
+		p.place(idx, best)
+		gain := p.gain(party)
+		score.value += gain.value
+		score.priority += gain.priority
+	}
+	p.keepIfBest(score)
+	for idx := range p.current {
+		p.unplace(idx)
+	}
+}
+
+func (p *planner) search(k int, score planScore) {
+	if p.expired() {
+		return
+	}
+	p.keepIfBest(score)
+	if k == len(p.order) {
+		return
+	}
+
+	// prune when even seating every remaining party cannot beat the best plan
+	bound := score
+	for _, idx := range p.order[k:] {
+		gain := p.gain(p.parties[idx])
+		bound.value += gain.value
+		bound.priority += gain.priority
+	}
+	if !p.bestScore.less(bound) {
+		return
+	}
+
+	idx := p.order[k]
+	party := p.parties[idx]
+	gain := p.gain(party)
+	for _, candidate := range p.candidates(party) {
+		p.place(idx, candidate)
+		p.search(k+1, planScore{value: score.value + gain.value, priority: score.priority + gain.priority})
+		p.unplace(idx)
+		if p.timedOut {
+			return
+		}
+	}
+	p.search(k+1, score)
+}
+
+func (p *planner) keepIfBest(score planScore) {
+	if p.best != nil && !p.bestScore.less(score) {
+		return
+	}
+	p.best = make([][][]int, len(p.current))
+	copy(p.best, p.current)
+	p.bestScore = score
+}
+
+func (p *planner) place(idx int, seatCoords [][]int) {
+	for _, seat := range seatCoords {
+		p.cinema.seats.reserve(seat[Row], seat[Col], p.parties[idx].GroupName)
+	}
+	p.current[idx] = seatCoords
+}
+
+func (p *planner) unplace(idx int) {
+	for _, seat := range p.current[idx] {
+		p.cinema.seats.cancel(seat[Row], seat[Col])
+	}
+	p.current[idx] = nil
+}
+
+// candidates lists up to maxCandidates blocks of seats the party can reserve in the current plan,
+// fewer when the deadline passes
+func (p *planner) candidates(party Party) [][][]int {
+	c := p.cinema
+	result := make([][][]int, 0)
+	if party.Size <= c.columns {
+		for i := 0; i < c.rows && len(result) < maxCandidates && !p.expired(); i++ {
+			for j := 0; j+party.Size <= c.columns && len(result) < maxCandidates; j++ {
+				candidate := make([][]int, 0, party.Size)
+				for k := j; k < j+party.Size; k++ {
+					candidate = append(candidate, []int{i, k})
+				}
+				if c.checkReservation(candidate, party.GroupName, ReserveOptions{}) == nil {
+					result = append(result, candidate)
+				}
+			}
+		}
+		return result
+	}
+	for i := 0; i < c.rows && len(result) < maxCandidates && !p.expired(); i++ {
+		for j := 0; j < c.columns && len(result) < maxCandidates; j++ {
+			candidate := c.growBlock(i, j, party.Size)
+			if len(candidate) == party.Size && c.checkReservation(candidate, party.GroupName, ReserveOptions{}) == nil {
+				result = append(result, candidate)
+			}
+		}
+	}
+	return result
+}
+
+func (p *planner) plan(optimal bool) (*SeatingPlan, error) {
+	result := &SeatingPlan{
+		Assignments: make([]PartyAssignment, 0),
+		Unseated:    make([]string, 0),
+		Optimal:     optimal,
+	}
+	for i, party := range p.parties {
+		if p.best[i] == nil {
+			result.Unseated = append(result.Unseated, party.GroupName)
+			continue
+		}
+		seats, err := p.cinema.ToPbSeats(p.best[i])
+		if err != nil {
+			return nil, err
+		}
+		result.Assignments = append(result.Assignments, PartyAssignment{
+			GroupName:  party.GroupName,
+			SeatCoords: p.best[i],
+			Seats:      seats,
+		})
+		result.SeatedPeople += party.Size
+		result.SeatedParties++
+	}
+	return result, nil
+}
+
+// damage counts the free seats another group could still reserve that would be lost
+// by reserving the given seats
+func (c *Cinema) damage(seatCoords [][]int) int {
+	count := 0
+	for i := 0; i < c.rows; i++ {
+		for j := 0; j < c.columns; j++ {
+			if c.seats.at(i, j).status != Available || c.nearReserved(i, j) {
+				continue
+			}
+			for _, seat := range seatCoords {
+				if c.tooClose(i, j, seat[Row], seat[Col]) {
+					count++
+					break
+				}
+			}
+		}
+	}
+	return count
+}
+
+// nearReserved reports whether a reserved seat is within the minimum distance of (row, col)
+func (c *Cinema) nearReserved(row, col int) bool {
+	rows, cols := c.reach()
+	for i := max(0, row-rows); i <= min(c.rows-1, row+rows); i++ {
+		for j := max(0, col-cols); j <= min(c.columns-1, col+cols); j++ {
+			if c.seats.at(i, j).status == Reserved && c.tooClose(i, j, row, col) {
+				return true
+			}
+		}
+	}
+	return false
+}
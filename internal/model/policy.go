@@ -0,0 +1,150 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/t3201v/seat-arrangement/gen/cinema"
+)
+
+// DistancingPolicy replaces the minimum distance and the separation profile from its effective time
+type DistancingPolicy struct {
+	EffectiveFrom time.Time
+	MinDistance   int
+	Separation    SeparationProfile
+}
+
+// PolicyViolation is a reservation breaking a distancing policy
+type PolicyViolation struct {
+	GroupName  string
+	SeatCoords [][]int        // seats of the group too close to other groups
+	Seats      []*cinema.Seat // SeatCoords with their labels
+	TooCloseTo []string       // the other groups, sorted
+}
+
+// SetDistancingPolicies replaces the schedule of distancing policies and applies the one in
+// effect at the given time, even when it took effect before the last policy applied
+func (c *Cinema) SetDistancingPolicies(policies []DistancingPolicy, at time.Time) error {
+	schedule := slices.Clone(policies)
+	for _, policy := range schedule {
+		if policy.EffectiveFrom.IsZero() {
+			return errors.New("distancing policy must have an effective time")
+		}
+		if policy.MinDistance < 0 {
+			return fmt.Errorf("min distance of the policy effective from %s must not be negative", policy.EffectiveFrom.Format(time.RFC3339))
+		}
+		if err := policy.Separation.validate(); err != nil {
+			return err
+		}
+	}
+	sort.SliceStable(schedule, func(i, j int) bool { return schedule[i].EffectiveFrom.Before(schedule[j].EffectiveFrom) })
+	for i := 1; i < len(schedule); i++ {
+		if schedule[i].EffectiveFrom.Equal(schedule[i-1].EffectiveFrom) {
+			return fmt.Errorf("two distancing policies are effective from %s", schedule[i].EffectiveFrom.Format(time.RFC3339))
+		}
+	}
+	c.policies = schedule
+	c.policyApplied = time.Time{}
+	c.configChanged()
+	c.ApplyDistancingPolicy(at)
+	return nil
+}
+
+// DistancingPolicies returns the schedule of distancing policies, sorted by effective time
+func (c *Cinema) DistancingPolicies() []DistancingPolicy {
+	return slices.Clone(c.policies)
+}
+
+// PolicyAt returns the policy in effect at the given time, if any
+func (c *Cinema) PolicyAt(at time.Time) (DistancingPolicy, bool) {
+	i := sort.Search(len(c.policies), func(i int) bool { return c.policies[i].EffectiveFrom.After(at) })
+	if i == 0 {
+		return DistancingPolicy{}, false
+	}
+	return c.policies[i-1], true
+}
+
+// NextPolicy returns the first policy effective after the given time, if any
+func (c *Cinema) NextPolicy(at time.Time) (DistancingPolicy, bool) {
+	i := sort.Search(len(c.policies), func(i int) bool { return c.policies[i].EffectiveFrom.After(at) })
+	if i == len(c.policies) {
+		return DistancingPolicy{}, false
+	}
+	return c.policies[i], true
+}
+
+// PolicyDue reports whether a policy took effect at the given time since the last one applied
+func (c *Cinema) PolicyDue(at time.Time) bool {
+	policy, ok := c.PolicyAt(at)
+	return ok && policy.EffectiveFrom.After(c.policyApplied)
+}
+
+// ApplyDistancingPolicy makes the policy in effect at the given time the distance rule of the
+// cinema, once per policy so that later changes of the rule stand until the next policy.
+// Existing reservations are kept even when they break the new rule.
+func (c *Cinema) ApplyDistancingPolicy(at time.Time) bool {
+	if !c.PolicyDue(at) {
+		return false
+	}
+	policy, _ := c.PolicyAt(at)
+	c.minDistance = policy.MinDistance
+	c.separation = policy.Separation
+	c.policyApplied = policy.EffectiveFrom
+	c.configChanged()
+	return true
+}
+
+// PolicyViolations lists the reservations which break the policy, sorted by group name
+func (c *Cinema) PolicyViolations(policy DistancingPolicy) []PolicyViolation {
+	scratch := c.Clone()
+	scratch.minDistance = policy.MinDistance
+	scratch.separation = policy.Separation
+	rows, cols := scratch.reach()
+
+	byGroup := make(map[string]*PolicyViolation)
+	others := make(map[string]map[string]bool)
+	for i := 0; i < c.rows; i++ {
+		for j := 0; j < c.columns; j++ {
+			seat := c.seats.at(i, j)
+			if seat.status != Reserved {
+				continue
+			}
+			tooClose := false
+			for k := max(0, i-rows); k <= min(c.rows-1, i+rows); k++ {
+				for l := max(0, j-cols); l <= min(c.columns-1, j+cols); l++ {
+					other := c.seats.at(k, l)
+					if other.status != Reserved || other.groupName == seat.groupName || !scratch.tooClose(i, j, k, l) {
+						continue
+					}
+					tooClose = true
+					if others[seat.groupName] == nil {
+						others[seat.groupName] = make(map[string]bool)
+					}
+					others[seat.groupName][other.groupName] = true
+				}
+			}
+			if !tooClose {
+				continue
+			}
+			if byGroup[seat.groupName] == nil {
+				byGroup[seat.groupName] = &PolicyViolation{GroupName: seat.groupName}
+			}
+			byGroup[seat.groupName].SeatCoords = append(byGroup[seat.groupName].SeatCoords, []int{i, j})
+		}
+	}
+
+	result := make([]PolicyViolation, 0, len(byGroup))
+	for name, violation := range byGroup {
+		for other := range others[name] {
+			violation.TooCloseTo = append(violation.TooCloseTo, other)
+		}
+		sort.Strings(violation.TooCloseTo)
+		violation.Seats, _ = c.ToPbSeats(violation.SeatCoords)
+		result = append(result, *violation)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].GroupName < result[j].GroupName })
+	return result
+}
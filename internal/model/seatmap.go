@@ -0,0 +1,160 @@
+package model
+
+import "github.com/t3201v/seat-arrangement/gen/cinema"
+
+// SeatState is the state of a seat as seen by a group
+type SeatState int
+
+const (
+	StateAvailable SeatState = iota
+	StateReserved
+	StateHeld
+	StateBlocked // free but too close to another group
+	StateUnsellable
+)
+
+// SeatView describes a seat of the seat map
+type SeatView struct {
+	Row        int
+	Col        int
+	Label      string
+	Category   SeatCategory
+	State      SeatState
+	GroupName  string   // group holding the seat, empty if free
+	NearGroups []string // groups whose exclusion zone covers the free seat
+}
+
+// SeatMap is the state of every seat row by row
+type SeatMap struct {
+	Rows         int
+	Columns      int
+	MinDistance  int
+	Zone         []string // exclusion zone around a reserved seat, see ExclusionZone
+	RowLabels    []string
+	ColumnLabels []string // seat numbers of every column, following the label scheme
+	Seats        [][]SeatView
+}
+
+// SeatMap returns the state of every seat as seen by the group.
+// Free seats too close to another group than the given one are blocked.
+func (c *Cinema) SeatMap(groupName string) *SeatMap {
+	result := &SeatMap{
+		Rows:         c.rows,
+		Columns:      c.columns,
+		MinDistance:  c.minDistance,
+		Zone:         c.ExclusionZone(),
+		RowLabels:    make([]string, c.rows),
+		ColumnLabels: make([]string, c.columns),
+		Seats:        make([][]SeatView, c.rows),
+	}
+	for j := 0; j < c.columns; j++ {
+		result.ColumnLabels[j] = c.seatNumber(j)
+	}
+	for i := 0; i < c.rows; i++ {
+		result.RowLabels[i] = c.RowLabel(i)
+		result.Seats[i] = make([]SeatView, c.columns)
+		for j := 0; j < c.columns; j++ {
+			view := SeatView{
+				Row:      i,
+				Col:      j,
+				Label:    c.SeatLabel(i, j),
+				Category: c.SeatCategoryOf(i, j),
+			}
+			if c.seats.at(i, j).status != Reserved {
+				view.NearGroups = c.nearGroups(i, j)
+			}
+			switch {
+			case c.isHeld(i, j):
+				view.State = StateHeld
+				view.GroupName = c.seats.at(i, j).groupName
+			case c.seats.at(i, j).status == Reserved:
+				view.State = StateReserved
+				view.GroupName = c.seats.at(i, j).groupName
+			case c.IsUnsellable(i, j):
+				view.State = StateUnsellable
+			case c.nearOtherGroup(i, j, groupName):
+				view.State = StateBlocked
+			default:
+				view.State = StateAvailable
+			}
+			result.Seats[i][j] = view
+		}
+	}
+	return result
+}
+
+// nearGroups lists the groups with a seat within the minimum distance of (row, col)
+func (c *Cinema) nearGroups(row, col int) []string {
+	var result []string
+	seen := make(map[string]bool)
+	rows, cols := c.reach()
+	for i := max(0, row-rows); i <= min(c.rows-1, row+rows); i++ {
+		for j := max(0, col-cols); j <= min(c.columns-1, col+cols); j++ {
+			seat := c.seats.at(i, j)
+			if seat.status != Reserved || seen[seat.groupName] {
+				continue
+			}
+			if c.tooClose(i, j, row, col) {
+				seen[seat.groupName] = true
+				result = append(result, seat.groupName)
+			}
+		}
+	}
+	return result
+}
+
+// nearOtherGroup reports whether a seat of a group other than the given one is within the minimum distance of (row, col)
+func (c *Cinema) nearOtherGroup(row, col int, groupName string) bool {
+	rows, cols := c.reach()
+	for i := max(0, row-rows); i <= min(c.rows-1, row+rows); i++ {
+		for j := max(0, col-cols); j <= min(c.columns-1, col+cols); j++ {
+			seat := c.seats.at(i, j)
+			if seat.status != Reserved || seat.groupName == groupName {
+				continue
+			}
+			if c.tooClose(i, j, row, col) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ToPb converts the seat map, names of groups other than the given one are hidden unless
+// revealGroups is set. The seats reserved or held for the group are owned, none without a group.
+func (m *SeatMap) ToPb(groupName string, revealGroups bool) *cinema.GetSeatMapResponse {
+	rows := make([]*cinema.SeatRow, 0, len(m.Seats))
+	for i, row := range m.Seats {
+		seats := make([]*cinema.SeatState, 0, len(row))
+		for _, view := range row {
+			owned := groupName != "" && view.GroupName == groupName && (view.State == StateReserved || view.State == StateHeld)
+			pb := &cinema.SeatState{
+				Seat: &cinema.Seat{
+					Row:      int32(view.Row),
+					Column:   int32(view.Col),
+					Category: cinema.SeatCategory(view.Category),
+					Label:    view.Label,
+				},
+				Label:  view.Label,
+				Status: cinema.SeatStatus(view.State),
+				Owned:  owned,
+			}
+			if owned || revealGroups {
+				pb.GroupName = view.GroupName
+			}
+			seats = append(seats, pb)
+		}
+		rows = append(rows, &cinema.SeatRow{
+			Row:   int32(i),
+			Label: m.RowLabels[i],
+			Seats: seats,
+		})
+	}
+	return &cinema.GetSeatMapResponse{
+		Rows:          int32(m.Rows),
+		Columns:       int32(m.Columns),
+		MinDistance:   int32(m.MinDistance),
+		SeatRows:      rows,
+		ExclusionZone: m.Zone,
+	}
+}
@@ -0,0 +1,159 @@
+package model
+
+import (
+	"maps"
+	"math/bits"
+	"slices"
+	"sync/atomic"
+)
+
+// seatGrid stores the seats of a cinema compactly: one bit per seat for the status and, for
+// reserved seats, the group as a small integer interned in a table of names. Clones share the
+// storage until one of them changes it.
+type seatGrid struct {
+	rows    int
+	columns int
+	data    *seatData
+}
+
+type seatData struct {
+	shared   atomic.Bool // another grid uses the storage, it is copied before a change
+	reserved []uint64    // bit row*columns+col is set when the seat is reserved
+	groups   []uint32    // group id of every seat, nil until a group other than "" reserves
+	names    []string    // group names by id, id 0 is the empty name and never freed
+	counts   []int       // reserved seats by group id
+	ids      map[string]uint32
+	free     []uint32 // ids of the groups without seats left
+}
+
+func newSeatGrid(rows, columns int) seatGrid {
+	return seatGrid{
+		rows:    rows,
+		columns: columns,
+		data: &seatData{
+			reserved: make([]uint64, (rows*columns+63)/64),
+			names:    []string{""},
+			counts:   []int{0},
+		},
+	}
+}
+
+func (g *seatGrid) empty() bool {
+	return g.rows == 0 || g.columns == 0
+}
+
+// at returns the seat, (row, col) must be in the grid
+func (g *seatGrid) at(row, col int) Seat {
+	i := row*g.columns + col
+	if g.data.reserved[i/64]&(1<<(i%64)) == 0 {
+		return Seat{status: Available}
+	}
+	if g.data.groups == nil {
+		return Seat{status: Reserved}
+	}
+	return Seat{status: Reserved, groupName: g.data.names[g.data.groups[i]]}
+}
+
+// reservedCount returns the number of reserved seats
+func (g *seatGrid) reservedCount() int {
+	n := 0
+	for _, word := range g.data.reserved {
+		n += bits.OnesCount64(word)
+	}
+	return n
+}
+
+// reserve marks the seat reserved by the group, whatever its status
+func (g *seatGrid) reserve(row, col int, groupName string) {
+	g.own()
+	i := row*g.columns + col
+	d := g.data
+	if d.reserved[i/64]&(1<<(i%64)) != 0 {
+		d.release(i)
+	}
+	d.reserved[i/64] |= 1 << (i % 64)
+	id := d.intern(groupName)
+	d.counts[id]++
+	if id != 0 && d.groups == nil {
+		d.groups = make([]uint32, g.rows*g.columns)
+	}
+	if d.groups != nil {
+		d.groups[i] = id
+	}
+}
+
+// cancel marks the seat available
+func (g *seatGrid) cancel(row, col int) {
+	i := row*g.columns + col
+	if g.data.reserved[i/64]&(1<<(i%64)) == 0 {
+		return
+	}
+	g.own()
+	g.data.release(i)
+	g.data.reserved[i/64] &^= 1 << (i % 64)
+}
+
+// clone returns a grid sharing the storage, the first of them to change copies it
+func (g *seatGrid) clone() seatGrid {
+	if g.data != nil {
+		g.data.shared.Store(true)
+	}
+	return *g
+}
+
+// own copies the storage when it is shared
+func (g *seatGrid) own() {
+	if !g.data.shared.Load() {
+		return
+	}
+	d := g.data
+	g.data = &seatData{
+		reserved: slices.Clone(d.reserved),
+		groups:   slices.Clone(d.groups),
+		names:    slices.Clone(d.names),
+		counts:   slices.Clone(d.counts),
+		ids:      maps.Clone(d.ids),
+		free:     slices.Clone(d.free),
+	}
+}
+
+// intern returns the id of the group name, adding it if needed
+func (d *seatData) intern(groupName string) uint32 {
+	if groupName == "" {
+		return 0
+	}
+	if id, ok := d.ids[groupName]; ok {
+		return id
+	}
+	if d.ids == nil {
+		d.ids = make(map[string]uint32)
+	}
+	var id uint32
+	if n := len(d.free); n > 0 {
+		id = d.free[n-1]
+		d.free = d.free[:n-1]
+		d.names[id] = groupName
+	} else {
+		id = uint32(len(d.names))
+		d.names = append(d.names, groupName)
+		d.counts = append(d.counts, 0)
+	}
+	d.ids[groupName] = id
+	return id
+}
+
+// release forgets the group of the reserved seat i, freeing its id when it has no seat left
+func (d *seatData) release(i int) {
+	var id uint32
+	if d.groups != nil {
+		id = d.groups[i]
+		d.groups[i] = 0
+	}
+	d.counts[id]--
+	if id == 0 || d.counts[id] > 0 {
+		return
+	}
+	delete(d.ids, d.names[id])
+	d.names[id] = ""
+	d.free = append(d.free, id)
+}
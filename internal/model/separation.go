@@ -0,0 +1,138 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/t3201v/seat-arrangement/internal/helper"
+	"github.com/t3201v/seat-arrangement/internal/libs/util"
+)
+
+// SeparationProfile replaces the minimum distance by independent minimums per direction.
+// Seats of other groups may not be within Horizontal seats in the same row, within Vertical
+// rows in the same column, nor within Diagonal rows and seats elsewhere. The Mask adds a custom
+// exclusion zone: rows of '.' and 'X' centered on the seat 'O', the first row towards the screen.
+type SeparationProfile struct {
+	Horizontal int
+	Vertical   int
+	Diagonal   int
+	Mask       []string
+}
+
+// IsZero reports whether the profile is unset, the minimum distance applies then
+func (p SeparationProfile) IsZero() bool {
+	return p.Horizontal == 0 && p.Vertical == 0 && p.Diagonal == 0 && len(p.Mask) == 0
+}
+
+func (p SeparationProfile) validate() error {
+	if p.Horizontal < 0 || p.Vertical < 0 || p.Diagonal < 0 {
+		return errors.New("separation minimums must not be negative")
+	}
+	if len(p.Mask) == 0 {
+		return nil
+	}
+	if len(p.Mask)%2 == 0 || len(p.Mask[0])%2 == 0 {
+		return errors.New("separation mask must have an odd number of rows and columns")
+	}
+	for i, row := range p.Mask {
+		if len(row) != len(p.Mask[0]) {
+			return fmt.Errorf("row %d of the separation mask has %d cells, want %d", i, len(row), len(p.Mask[0]))
+		}
+		for j, cell := range row {
+			center := i == len(p.Mask)/2 && j == len(row)/2
+			if center && cell != 'O' || !center && cell != '.' && cell != 'X' {
+				return fmt.Errorf("separation mask must be '.' and 'X' around a single 'O' in its center, got %q at (%d, %d)", cell, i, j)
+			}
+		}
+	}
+	return nil
+}
+
+// inMask reports whether the offset from a seat is in its custom exclusion zone
+func (p SeparationProfile) inMask(dr, dc int) bool {
+	if len(p.Mask) == 0 {
+		return false
+	}
+	h, w := len(p.Mask)/2, len(p.Mask[0])/2
+	if util.Abs(dr) > h || util.Abs(dc) > w {
+		return false
+	}
+	return p.Mask[h+dr][w+dc] == 'X'
+}
+
+// excludes reports whether two seats at the offset are too close for different groups
+func (p SeparationProfile) excludes(dr, dc int) bool {
+	if p.inMask(dr, dc) || p.inMask(-dr, -dc) {
+		return true
+	}
+	dr, dc = util.Abs(dr), util.Abs(dc)
+	switch {
+	case dr == 0:
+		return dc <= p.Horizontal
+	case dc == 0:
+		return dr <= p.Vertical
+	default:
+		return dr <= p.Diagonal && dc <= p.Diagonal
+	}
+}
+
+// SetSeparation sets the separation profile, the zero profile brings the minimum distance back
+func (c *Cinema) SetSeparation(profile SeparationProfile) error {
+	if err := profile.validate(); err != nil {
+		return err
+	}
+	profile.Mask = slices.Clone(profile.Mask)
+	c.separation = profile
+	c.configChanged()
+	return nil
+}
+
+// Separation returns the separation profile
+func (c *Cinema) Separation() SeparationProfile {
+	return c.separation
+}
+
+// tooClose reports whether two seats are too close to be reserved by different groups
+func (c *Cinema) tooClose(row1, col1, row2, col2 int) bool {
+	if c.separation.IsZero() {
+		return helper.ManhattanDistance(row1, col1, row2, col2) <= c.minDistance
+	}
+	return c.separation.excludes(row2-row1, col2-col1)
+}
+
+// reach returns how many rows and seats away another group may be too close
+func (c *Cinema) reach() (rows, cols int) {
+	if c.separation.IsZero() {
+		return c.minDistance, c.minDistance
+	}
+	p := c.separation
+	rows, cols = max(p.Vertical, p.Diagonal), max(p.Horizontal, p.Diagonal)
+	if len(p.Mask) > 0 {
+		rows, cols = max(rows, len(p.Mask)/2), max(cols, len(p.Mask[0])/2)
+	}
+	return rows, cols
+}
+
+// ExclusionZone draws the seats around a reserved seat 'O' which other groups cannot take
+// as 'X', the others as '.', the first row towards the screen
+func (c *Cinema) ExclusionZone() []string {
+	rows, cols := c.reach()
+	result := make([]string, 0, 2*rows+1)
+	for dr := -rows; dr <= rows; dr++ {
+		var sb strings.Builder
+		for dc := -cols; dc <= cols; dc++ {
+			switch {
+			case dr == 0 && dc == 0:
+				sb.WriteByte('O')
+			case c.tooClose(0, 0, dr, dc):
+				sb.WriteByte('X')
+			default:
+				sb.WriteByte('.')
+			}
+		}
+		result = append(result, sb.String())
+	}
+	return result
+}
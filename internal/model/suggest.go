@@ -0,0 +1,102 @@
+package model
+
+import (
+	"time"
+)
+
+// SuggestSeats looks for seats a group of the given size can reserve together.
+// Seats in the same row are preferred, accessible groups get wheelchair spaces with
+// their companion seats first while other groups are kept away from them.
+// It returns nil when no seats can be found.
+func (c *Cinema) SuggestSeats(size int, groupName string, accessible bool, at time.Time) [][]int {
+	if size <= 0 || size > c.rows*c.columns {
+		return nil
+	}
+	opts := ReserveOptions{Accessible: accessible, At: at}
+
+	var best [][]int
+	bestScore := 0
+	consider := func(candidate [][]int) {
+		if len(candidate) != size || c.checkReservation(candidate, groupName, opts) != nil {
+			return
+		}
+		score := c.suggestionScore(candidate, accessible)
+		if best == nil || score > bestScore {
+			best, bestScore = candidate, score
+		}
+	}
+
+	// blocks of seats in the same row
+	for i := 0; i < c.rows; i++ {
+		for j := 0; j+size <= c.columns; j++ {
+			candidate := make([][]int, 0, size)
+			for k := j; k < j+size; k++ {
+				candidate = append(candidate, []int{i, k})
+			}
+			consider(candidate)
+		}
+	}
+
+	// blocks grown around every wheelchair space
+	if accessible {
+		for i := 0; i < c.rows; i++ {
+			for j := 0; j < c.columns; j++ {
+				if c.SeatCategoryOf(i, j) == Wheelchair {
+					consider(c.growBlock(i, j, size))
+				}
+			}
+		}
+	}
+	return best
+}
+
+// suggestionScore ranks candidate seats, a higher score is better
+func (c *Cinema) suggestionScore(seatCoords [][]int, accessible bool) int {
+	score := 0
+	for _, seat := range seatCoords {
+		category := c.SeatCategoryOf(seat[Row], seat[Col])
+		switch {
+		case accessible && category == Wheelchair:
+			score += 2
+		case accessible && category == Companion:
+			score++
+		case !accessible && isAccessible(category):
+			score-- // keep accessible seats for the groups that need them
+		}
+	}
+	return score
+}
+
+// growBlock collects up to size available seats connected to (row, col),
+// visiting companion seats before the others
+func (c *Cinema) growBlock(row, col, size int) [][]int {
+	if c.seats.at(row, col).status != Available {
+		return nil
+	}
+	visited := map[[2]int]bool{{row, col}: true}
+	block := [][]int{{row, col}}
+	for k := 0; k < len(block) && len(block) < size; k++ {
+		var companions, others [][]int
+		for _, d := range directions {
+			i, j := block[k][Row]+d[Row], block[k][Col]+d[Col]
+			if i < 0 || i >= c.rows || j < 0 || j >= c.columns || visited[[2]int{i, j}] {
+				continue
+			}
+			if c.seats.at(i, j).status != Available {
+				continue
+			}
+			visited[[2]int{i, j}] = true
+			if c.SeatCategoryOf(i, j) == Companion {
+				companions = append(companions, []int{i, j})
+			} else {
+				others = append(others, []int{i, j})
+			}
+		}
+		for _, seat := range append(companions, others...) {
+			if len(block) < size {
+				block = append(block, seat)
+			}
+		}
+	}
+	return block
+}
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	maxNameLength        = 100
+	maxDescriptionLength = 1000
+)
+
+// Summary describes a cinema in listings
+type Summary struct {
+	ID          string // set by listings
+	LegacyID    string // numeric id of the previous versions, set by listings
+	Name        string
+	Description string
+	Rows        int
+	Columns     int
+	Seats       int // sellable seats
+	Reserved    int
+}
+
+// Occupancy is the share of the sellable seats reserved, from 0 to 1
+func (s Summary) Occupancy() float64 {
+	if s.Seats == 0 {
+		return 0
+	}
+	return float64(s.Reserved) / float64(s.Seats)
+}
+
+// SetDescription names and describes the cinema
+func (c *Cinema) SetDescription(name, description string) error {
+	name = strings.TrimSpace(name)
+	if utf8.RuneCountInString(name) > maxNameLength {
+		return fmt.Errorf("name must not be longer than %d characters", maxNameLength)
+	}
+	if utf8.RuneCountInString(description) > maxDescriptionLength {
+		return fmt.Errorf("description must not be longer than %d characters", maxDescriptionLength)
+	}
+	c.name = name
+	c.description = description
+	c.configChanged()
+	return nil
+}
+
+// Description returns the name and the description of the cinema
+func (c *Cinema) Description() (string, string) {
+	return c.name, c.description
+}
+
+// Summary counts the sellable and reserved seats of the cinema
+func (c *Cinema) Summary() Summary {
+	summary := Summary{Name: c.name, Description: c.description, Rows: c.rows, Columns: c.columns}
+	if !c.seats.empty() {
+		summary.Reserved = c.seats.reservedCount()
+	}
+	for i := 0; i < c.rows; i++ {
+		for j := 0; j < c.columns; j++ {
+			if !c.IsUnsellable(i, j) {
+				summary.Seats++
+			}
+		}
+	}
+	return summary
+}
+
+// HasReservations reports whether any seat is reserved or held
+func (c *Cinema) HasReservations() bool {
+	return !c.seats.empty() && c.seats.reservedCount() > 0
+}
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// DefaultTenant is the organization of the audit events logged before organizations existed
+const DefaultTenant = "default"
+
+const maxTenantLength = 64
+
+// ValidateTenant checks an organization id: 1 to 64 lower case letters, digits, '-' and '_'
+func ValidateTenant(tenant string) error {
+	if tenant == "" || len(tenant) > maxTenantLength {
+		return errors.New("tenant must have 1 to 64 characters")
+	}
+	for _, r := range tenant {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' && r != '_' {
+			return errors.New("tenant may only have lower case letters, digits, '-' and '_'")
+		}
+	}
+	return nil
+}
+
+// Venue is a place of an organization, its halls are cinemas
+type Venue struct {
+	ID        string
+	Name      string
+	Address   string
+	Halls     []string // ids of the cinemas of the venue
+	CreatedAt time.Time
+}
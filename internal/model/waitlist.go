@@ -0,0 +1,313 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/t3201v/seat-arrangement/gen/cinema"
+)
+
+// DefaultHoldFor is how long seats are held for a waitlisted party that does not tell
+const DefaultHoldFor = 15 * time.Minute
+
+// WaitlistEntry is a party waiting for seats to free up
+type WaitlistEntry struct {
+	GroupName  string
+	Size       int
+	Accessible bool          // the party needs wheelchair spaces
+	AutoHold   bool          // seats are held for the party when they free up, else it is notified
+	HoldFor    time.Duration // how long seats are held, DefaultHoldFor if zero
+	JoinedAt   time.Time
+}
+
+// Hold is seats reserved for a waitlisted party until it confirms them or the hold expires
+type Hold struct {
+	GroupName  string
+	SeatCoords [][]int
+	Seats      []*cinema.Seat // SeatCoords with their labels, set by Holds and HoldOf
+	Until      time.Time
+}
+
+// WaitlistOffer is seats found for a waitlisted party when serving the waitlist
+type WaitlistOffer struct {
+	GroupName  string
+	SeatCoords [][]int
+	Held       bool      // the seats are held, else the party was only notified
+	Until      time.Time // end of the hold
+}
+
+// PartyWaitlisted adds a party at the end of the waitlist
+type PartyWaitlisted struct {
+	Entry WaitlistEntry
+}
+
+type PartyLeftWaitlist struct {
+	GroupName string
+}
+
+// SeatsHeld reserves seats for a waitlisted party and takes it off the waitlist
+type SeatsHeld struct {
+	GroupName  string
+	SeatCoords [][]int
+	Until      time.Time
+}
+
+// HoldConfirmed turns held seats into a regular reservation
+type HoldConfirmed struct {
+	GroupName string
+}
+
+// HoldReleased frees held seats, when the hold expired or the party declined it
+type HoldReleased struct {
+	GroupName  string
+	SeatCoords [][]int
+}
+
+// WaitlistNotified tells a waitlisted party that seats freed up, it does not change the cinema
+type WaitlistNotified struct {
+	GroupName  string
+	SeatCoords [][]int
+}
+
+func (e PartyWaitlisted) apply(c *Cinema) error {
+	c.waitlist = append(c.waitlist, e.Entry)
+	return nil
+}
+
+func (e PartyLeftWaitlist) apply(c *Cinema) error {
+	c.waitlist = slices.DeleteFunc(c.waitlist, func(entry WaitlistEntry) bool { return entry.GroupName == e.GroupName })
+	return nil
+}
+
+func (e SeatsHeld) apply(c *Cinema) error {
+	if err := (SeatsReserved{GroupName: e.GroupName, SeatCoords: e.SeatCoords}).apply(c); err != nil {
+		return err
+	}
+	if c.holds == nil {
+		c.holds = make(map[string]Hold)
+	}
+	c.holds[e.GroupName] = Hold{GroupName: e.GroupName, SeatCoords: e.SeatCoords, Until: e.Until}
+	return PartyLeftWaitlist{GroupName: e.GroupName}.apply(c)
+}
+
+func (e HoldConfirmed) apply(c *Cinema) error {
+	delete(c.holds, e.GroupName)
+	return nil
+}
+
+func (e HoldReleased) apply(c *Cinema) error {
+	if err := (SeatsCancelled{SeatCoords: e.SeatCoords}).apply(c); err != nil {
+		return err
+	}
+	delete(c.holds, e.GroupName)
+	return nil
+}
+
+func (e WaitlistNotified) apply(c *Cinema) error {
+	return nil
+}
+
+// JoinWaitlist adds a party at the end of the waitlist and returns its position, from 1
+func (c *Cinema) JoinWaitlist(entry WaitlistEntry) (int, error) {
+	if entry.GroupName == "" {
+		return 0, errors.New("group name must not be empty")
+	}
+	if entry.Size <= 0 || entry.Size > c.rows*c.columns {
+		return 0, fmt.Errorf("party size must be in range [1, %d], got %d", c.rows*c.columns, entry.Size)
+	}
+	if entry.HoldFor < 0 {
+		return 0, fmt.Errorf("hold duration must not be negative, got %v", entry.HoldFor)
+	}
+	if _, err := c.WaitlistPosition(entry.GroupName); err == nil {
+		return 0, fmt.Errorf("group %q is already on the waitlist", entry.GroupName)
+	}
+	if c.hasGroup(entry.GroupName) {
+		return 0, fmt.Errorf("group %q already has reserved seats", entry.GroupName)
+	}
+	if entry.JoinedAt.IsZero() {
+		entry.JoinedAt = time.Now()
+	}
+	e := PartyWaitlisted{Entry: entry}
+	_ = e.apply(c)
+	c.emit(e)
+	return len(c.waitlist), nil
+}
+
+// LeaveWaitlist takes a party off the waitlist
+func (c *Cinema) LeaveWaitlist(groupName string) error {
+	if _, err := c.WaitlistPosition(groupName); err != nil {
+		return err
+	}
+	e := PartyLeftWaitlist{GroupName: groupName}
+	_ = e.apply(c)
+	c.emit(e)
+	return nil
+}
+
+// WaitlistPosition returns the position of a party on the waitlist, from 1
+func (c *Cinema) WaitlistPosition(groupName string) (int, error) {
+	for i, entry := range c.waitlist {
+		if entry.GroupName == groupName {
+			return i + 1, nil
+		}
+	}
+	return 0, fmt.Errorf("group %q is not on the waitlist", groupName)
+}
+
+// Waitlist returns the waiting parties in order
+func (c *Cinema) Waitlist() []WaitlistEntry {
+	return slices.Clone(c.waitlist)
+}
+
+// Holds returns the seats held for waitlisted parties, sorted by group name
+func (c *Cinema) Holds() []Hold {
+	result := make([]Hold, 0, len(c.holds))
+	for _, hold := range c.holds {
+		hold.Seats, _ = c.ToPbSeats(hold.SeatCoords)
+		result = append(result, hold)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].GroupName < result[j].GroupName })
+	return result
+}
+
+// HoldOf returns the seats held for a party, if any
+func (c *Cinema) HoldOf(groupName string) (Hold, bool) {
+	hold, ok := c.holds[groupName]
+	if ok {
+		hold.Seats, _ = c.ToPbSeats(hold.SeatCoords)
+	}
+	return hold, ok
+}
+
+// unhold takes cancelled seats out of their holds and drops the holds left without seats,
+// so that releasing a hold never cancels seats its group reserved again
+func (c *Cinema) unhold(seatCoords [][]int) {
+	if len(c.holds) == 0 {
+		return
+	}
+	cancelled := make(map[[2]int]bool, len(seatCoords))
+	for _, seat := range seatCoords {
+		cancelled[[2]int{seat[Row], seat[Col]}] = true
+	}
+	for groupName, hold := range c.holds {
+		kept := make([][]int, 0, len(hold.SeatCoords))
+		for _, seat := range hold.SeatCoords {
+			if !cancelled[[2]int{seat[Row], seat[Col]}] {
+				kept = append(kept, seat)
+			}
+		}
+		switch {
+		case len(kept) == 0:
+			delete(c.holds, groupName)
+		case len(kept) < len(hold.SeatCoords):
+			hold.SeatCoords = kept
+			c.holds[groupName] = hold
+		}
+	}
+}
+
+func (c *Cinema) isHeld(row, col int) bool {
+	_, ok := c.holds[c.seats.at(row, col).groupName]
+	return ok && c.seats.at(row, col).status == Reserved
+}
+
+// ServeWaitlist releases the expired holds then looks for seats for the waitlisted parties
+// in order. Parties asking for it get their seats held and leave the waitlist, the others
+// are notified of the seats and stay on it until they reserve. Seats offered to a party
+// are not offered to the parties after it.
+func (c *Cinema) ServeWaitlist(at time.Time) []WaitlistOffer {
+	c.ReleaseExpiredHolds(at)
+	offers := make([]WaitlistOffer, 0)
+	if len(c.waitlist) == 0 {
+		return offers
+	}
+	scratch := c.Clone()
+	for _, entry := range slices.Clone(c.waitlist) {
+		seatCoords := scratch.SuggestSeats(entry.Size, entry.GroupName, entry.Accessible, at)
+		if seatCoords == nil {
+			continue
+		}
+		_ = scratch.ReserveSeats(seatCoords, entry.GroupName, ReserveOptions{Accessible: entry.Accessible, At: at})
+		offer := WaitlistOffer{GroupName: entry.GroupName, SeatCoords: seatCoords}
+		if !entry.AutoHold {
+			c.emit(WaitlistNotified{GroupName: entry.GroupName, SeatCoords: seatCoords})
+			offers = append(offers, offer)
+			continue
+		}
+		holdFor := entry.HoldFor
+		if holdFor == 0 {
+			holdFor = DefaultHoldFor
+		}
+		e := SeatsHeld{GroupName: entry.GroupName, SeatCoords: seatCoords, Until: at.Add(holdFor)}
+		_ = e.apply(c)
+		c.emit(e)
+		offer.Held, offer.Until = true, e.Until
+		offers = append(offers, offer)
+	}
+	return offers
+}
+
+// ConfirmHold turns the seats held for a party into a regular reservation
+func (c *Cinema) ConfirmHold(groupName string, at time.Time) error {
+	hold, ok := c.holds[groupName]
+	if !ok {
+		return fmt.Errorf("no seats are held for group %q", groupName)
+	}
+	if !at.Before(hold.Until) {
+		return fmt.Errorf("hold of group %q expired at %s", groupName, hold.Until.Format(time.RFC3339))
+	}
+	e := HoldConfirmed{GroupName: groupName}
+	_ = e.apply(c)
+	c.emit(e)
+	return nil
+}
+
+// ReleaseHold frees the seats held for a party
+func (c *Cinema) ReleaseHold(groupName string) error {
+	hold, ok := c.holds[groupName]
+	if !ok {
+		return fmt.Errorf("no seats are held for group %q", groupName)
+	}
+	// seats of the hold cancelled meanwhile are not released again
+	seatCoords := make([][]int, 0, len(hold.SeatCoords))
+	for _, seat := range hold.SeatCoords {
+		if c.seats.at(seat[Row], seat[Col]).status == Reserved && c.seats.at(seat[Row], seat[Col]).groupName == groupName {
+			seatCoords = append(seatCoords, seat)
+		}
+	}
+	e := HoldReleased{GroupName: groupName, SeatCoords: seatCoords}
+	if err := e.apply(c); err != nil {
+		return err
+	}
+	c.emit(e)
+	return nil
+}
+
+// HoldExpired reports whether a hold expired at the given time and still blocks its seats
+func (c *Cinema) HoldExpired(at time.Time) bool {
+	for _, hold := range c.holds {
+		if !at.Before(hold.Until) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReleaseExpiredHolds frees the seats of the holds expired at the given time
+func (c *Cinema) ReleaseExpiredHolds(at time.Time) {
+	for _, hold := range c.Holds() {
+		if !at.Before(hold.Until) {
+			_ = c.ReleaseHold(hold.GroupName)
+		}
+	}
+}
+
+// leaveWaitlistOnReservation takes a party off the waitlist once it reserved seats by itself
+func (c *Cinema) leaveWaitlistOnReservation(groupName string) {
+	if _, err := c.WaitlistPosition(groupName); err == nil {
+		_ = c.LeaveWaitlist(groupName)
+	}
+}
@@ -0,0 +1,123 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"strings"
+
+	"github.com/t3201v/seat-arrangement/internal/model"
+)
+
+const (
+	fontScale = 2
+	// MaxPNGSize bounds the width and the height of a PNG in pixels, about 140 rows or columns
+	MaxPNGSize = 4096
+)
+
+// ErrTooLarge is returned for the seat maps drawn beyond MaxPNGSize
+var ErrTooLarge = errors.New("seat map too large for a png")
+
+// glyphs is a 3x5 pixel font, every row is 3 bits from left to right
+var glyphs = map[rune][5]uint8{
+	'0': {7, 5, 5, 5, 7}, '1': {2, 6, 2, 2, 7}, '2': {7, 1, 7, 4, 7}, '3': {7, 1, 7, 1, 7},
+	'4': {5, 5, 7, 1, 1}, '5': {7, 4, 7, 1, 7}, '6': {7, 4, 7, 5, 7}, '7': {7, 1, 1, 1, 1},
+	'8': {7, 5, 7, 5, 7}, '9': {7, 5, 7, 1, 7},
+	'A': {2, 5, 7, 5, 5}, 'B': {6, 5, 6, 5, 6}, 'C': {7, 4, 4, 4, 7}, 'D': {6, 5, 5, 5, 6},
+	'E': {7, 4, 6, 4, 7}, 'F': {7, 4, 6, 4, 4}, 'G': {7, 4, 5, 5, 7}, 'H': {5, 5, 7, 5, 5},
+	'I': {7, 2, 2, 2, 7}, 'J': {1, 1, 1, 5, 7}, 'K': {5, 5, 6, 5, 5}, 'L': {4, 4, 4, 4, 7},
+	'M': {5, 7, 7, 5, 5}, 'N': {6, 5, 5, 5, 5}, 'O': {7, 5, 5, 5, 7}, 'P': {7, 5, 7, 4, 4},
+	'Q': {7, 5, 5, 7, 1}, 'R': {6, 5, 6, 5, 5}, 'S': {7, 4, 7, 1, 7}, 'T': {7, 2, 2, 2, 2},
+	'U': {5, 5, 5, 5, 7}, 'V': {5, 5, 5, 5, 2}, 'W': {5, 5, 7, 7, 5}, 'X': {5, 5, 2, 5, 5},
+	'Y': {5, 5, 2, 2, 2}, 'Z': {7, 1, 2, 4, 7}, '-': {0, 0, 7, 0, 0},
+}
+
+// PNG writes the seat map as a PNG image, it draws the same picture as SVG
+// except for the group names which are not rendered
+func PNG(w io.Writer, m *model.SeatMap, opts Options) error {
+	l := newLayout(m)
+	if l.width > MaxPNGSize || l.height > MaxPNGSize {
+		return fmt.Errorf("%w: %dx%d pixels, at most %d", ErrTooLarge, l.width, l.height, MaxPNGSize)
+	}
+	img := image.NewRGBA(image.Rect(0, 0, l.width, l.height))
+	fillRect(img, 0, 0, l.width, l.height, colorBackground)
+
+	// screen
+	fillRect(img, margin, l.screenTop, l.width-2*margin, screenSize, colorScreen)
+	drawText(img, "SCREEN", l.width/2, l.screenTop+screenSize/2, color.RGBA{0xff, 0xff, 0xff, 0xff})
+
+	// column and row labels
+	for j, label := range m.ColumnLabels {
+		x, y := l.cell(0, j)
+		drawText(img, label, x+cellSize/2, y-10, colorText)
+	}
+	for i, label := range m.RowLabels {
+		x, y := l.cell(i, 0)
+		drawText(img, label, x-margin/2, y+cellSize/2, colorText)
+	}
+
+	// seats
+	for _, row := range m.Seats {
+		for _, view := range row {
+			x, y := l.cell(view.Row, view.Col)
+			fillRect(img, x, y, cellSize, cellSize, seatColor(view))
+			if opts.Overlay {
+				for k, groupName := range view.NearGroups {
+					inset := 1 + 2*k
+					if 2*inset >= cellSize {
+						break
+					}
+					strokeRect(img, x+inset, y+inset, cellSize-2*inset, cellSize-2*inset, groupColor(groupName))
+				}
+			}
+		}
+	}
+	return png.Encode(w, img)
+}
+
+func fillRect(img *image.RGBA, x, y, w, h int, c color.RGBA) {
+	for i := y; i < y+h; i++ {
+		for j := x; j < x+w; j++ {
+			img.SetRGBA(j, i, c)
+		}
+	}
+}
+
+// strokeRect draws a dashed outline
+func strokeRect(img *image.RGBA, x, y, w, h int, c color.RGBA) {
+	for j := x; j < x+w; j++ {
+		if (j-x)%4 < 3 {
+			img.SetRGBA(j, y, c)
+			img.SetRGBA(j, y+h-1, c)
+		}
+	}
+	for i := y; i < y+h; i++ {
+		if (i-y)%4 < 3 {
+			img.SetRGBA(x, i, c)
+			img.SetRGBA(x+w-1, i, c)
+		}
+	}
+}
+
+// drawText draws the text centered on (cx, cy), unknown characters are left blank
+func drawText(img *image.RGBA, text string, cx, cy int, c color.RGBA) {
+	text = strings.ToUpper(text)
+	advance := 4 * fontScale
+	x := cx - (len(text)*advance-fontScale)/2
+	y := cy - 5*fontScale/2
+	for _, r := range text {
+		glyph := glyphs[r]
+		for row := 0; row < 5; row++ {
+			for col := 0; col < 3; col++ {
+				if glyph[row]&(4>>col) == 0 {
+					continue
+				}
+				fillRect(img, x+col*fontScale, y+row*fontScale, fontScale, fontScale, c)
+			}
+		}
+		x += advance
+	}
+}
@@ -0,0 +1,153 @@
+package render
+
+import (
+	"fmt"
+	"hash/fnv"
+	"image/color"
+	"io"
+	"strings"
+
+	"github.com/t3201v/seat-arrangement/internal/model"
+)
+
+const (
+	cellSize   = 24
+	cellGap    = 4
+	margin     = 32 // room for the row and column labels
+	screenSize = 20
+)
+
+// Func renders a seat map, SVG and PNG are Funcs
+type Func func(w io.Writer, m *model.SeatMap, opts Options) error
+
+// Options tunes the rendering of a seat map
+type Options struct {
+	Overlay    bool // draw the exclusion zone of every group around its seats
+	ShowGroups bool // add group names to the seat titles of the SVG
+}
+
+var (
+	colorBackground = color.RGBA{0xff, 0xff, 0xff, 0xff}
+	colorText       = color.RGBA{0x33, 0x33, 0x33, 0xff}
+	colorScreen     = color.RGBA{0x44, 0x44, 0x44, 0xff}
+	colorAvailable  = color.RGBA{0xc8, 0xe6, 0xc9, 0xff}
+	colorHeld       = color.RGBA{0xff, 0xe0, 0x82, 0xff}
+	colorBlocked    = color.RGBA{0xee, 0xee, 0xee, 0xff}
+	colorUnsellable = color.RGBA{0x75, 0x75, 0x75, 0xff}
+
+	// groups get a color of the palette from the hash of their name
+	palette = []color.RGBA{
+		{0xe5, 0x73, 0x73, 0xff},
+		{0x64, 0xb5, 0xf6, 0xff},
+		{0xba, 0x68, 0xc8, 0xff},
+		{0xff, 0xb7, 0x4d, 0xff},
+		{0x4d, 0xb6, 0xac, 0xff},
+		{0xf0, 0x62, 0x92, 0xff},
+		{0x79, 0x86, 0xcb, 0xff},
+		{0xa1, 0x88, 0x7f, 0xff},
+	}
+)
+
+func groupColor(groupName string) color.RGBA {
+	h := fnv.New32a()
+	h.Write([]byte(groupName))
+	return palette[h.Sum32()%uint32(len(palette))]
+}
+
+func seatColor(view model.SeatView) color.RGBA {
+	switch view.State {
+	case model.StateReserved:
+		return groupColor(view.GroupName)
+	case model.StateHeld:
+		return colorHeld
+	case model.StateBlocked:
+		return colorBlocked
+	case model.StateUnsellable:
+		return colorUnsellable
+	default:
+		return colorAvailable
+	}
+}
+
+// layout computes where things are drawn, shared by the SVG and PNG renderers
+type layout struct {
+	width, height int
+	screenTop     int
+	gridTop       int
+}
+
+func newLayout(m *model.SeatMap) layout {
+	gridTop := margin + screenSize + margin
+	return layout{
+		width:     2*margin + m.Columns*(cellSize+cellGap),
+		height:    gridTop + m.Rows*(cellSize+cellGap) + margin,
+		screenTop: margin / 2,
+		gridTop:   gridTop,
+	}
+}
+
+func (l layout) cell(row, col int) (x, y int) {
+	return margin + col*(cellSize+cellGap), l.gridTop + row*(cellSize+cellGap)
+}
+
+func hex(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+// SVG writes the seat map as an SVG document
+func SVG(w io.Writer, m *model.SeatMap, opts Options) error {
+	l := newLayout(m)
+	var sb strings.Builder
+	fmt.Fprintf(&sb, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="11">`+"\n",
+		l.width, l.height, l.width, l.height)
+	fmt.Fprintf(&sb, `<rect width="100%%" height="100%%" fill="%s"/>`+"\n", hex(colorBackground))
+
+	// screen
+	fmt.Fprintf(&sb, `<rect x="%d" y="%d" width="%d" height="%d" rx="4" fill="%s"/>`+"\n",
+		margin, l.screenTop, l.width-2*margin, screenSize, hex(colorScreen))
+	fmt.Fprintf(&sb, `<text x="%d" y="%d" text-anchor="middle" fill="#ffffff">SCREEN</text>`+"\n",
+		l.width/2, l.screenTop+screenSize/2+4)
+
+	// column and row labels
+	for j, label := range m.ColumnLabels {
+		x, y := l.cell(0, j)
+		fmt.Fprintf(&sb, `<text x="%d" y="%d" text-anchor="middle" fill="%s">%s</text>`+"\n",
+			x+cellSize/2, y-8, hex(colorText), escape(label))
+	}
+	for i, label := range m.RowLabels {
+		x, y := l.cell(i, 0)
+		fmt.Fprintf(&sb, `<text x="%d" y="%d" text-anchor="middle" fill="%s">%s</text>`+"\n",
+			x-margin/2, y+cellSize/2+4, hex(colorText), escape(label))
+	}
+
+	// seats
+	for _, row := range m.Seats {
+		for _, view := range row {
+			x, y := l.cell(view.Row, view.Col)
+			title := view.Label
+			if opts.ShowGroups && view.GroupName != "" {
+				title += " " + view.GroupName
+			}
+			fmt.Fprintf(&sb, `<rect x="%d" y="%d" width="%d" height="%d" rx="3" fill="%s"><title>%s</title></rect>`+"\n",
+				x, y, cellSize, cellSize, hex(seatColor(view)), escape(title))
+			if opts.Overlay {
+				for k, groupName := range view.NearGroups {
+					inset := 1 + 2*k
+					if 2*inset >= cellSize {
+						break
+					}
+					fmt.Fprintf(&sb, `<rect x="%d" y="%d" width="%d" height="%d" fill="none" stroke="%s" stroke-width="2" stroke-dasharray="3,2"/>`+"\n",
+						x+inset, y+inset, cellSize-2*inset, cellSize-2*inset, hex(groupColor(groupName)))
+				}
+			}
+		}
+	}
+	sb.WriteString("</svg>\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func escape(s string) string {
+	r := strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+	return r.Replace(s)
+}
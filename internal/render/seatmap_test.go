@@ -0,0 +1,82 @@
+package render
+
+import (
+	"bytes"
+	"errors"
+	"image/png"
+	"strings"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/t3201v/seat-arrangement/internal/model"
+)
+
+func TestSVG(t *testing.T) {
+	c := model.NewCinema(log.StandardLogger(), 2, 4, 1)
+	if err := c.SetLabelScheme(model.LabelScheme{Numbering: model.FromCenter}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.ReserveSeats([][]int{{0, 0}}, "<smith>", model.ReserveOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	m := c.SeatMap("")
+
+	var buf bytes.Buffer
+	if err := SVG(&buf, m, Options{ShowGroups: true}); err != nil {
+		t.Fatal(err)
+	}
+	svg := buf.String()
+	for _, want := range []string{
+		`<svg xmlns="http://www.w3.org/2000/svg" width="176" height="172"`,
+		`>SCREEN</text>`,
+		// column headers follow the numbering of the label scheme
+		`<text x="44" y="76" text-anchor="middle" fill="#333333">L2</text>`,
+		`<text x="72" y="76" text-anchor="middle" fill="#333333">L1</text>`,
+		`<text x="100" y="76" text-anchor="middle" fill="#333333">R1</text>`,
+		`<text x="128" y="76" text-anchor="middle" fill="#333333">R2</text>`,
+		`<text x="16" y="100" text-anchor="middle" fill="#333333">A</text>`,
+		`<title>AL2 &lt;smith&gt;</title>`,
+		`<title>BR2</title>`,
+	} {
+		if !strings.Contains(svg, want) {
+			t.Errorf("SVG() does not contain %s:\n%s", want, svg)
+		}
+	}
+	if strings.Contains(svg, ">1</text>") {
+		t.Errorf("SVG() numbers the columns from 1 with a centered numbering:\n%s", svg)
+	}
+
+	buf.Reset()
+	if err := SVG(&buf, m, Options{}); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(buf.String(), "smith") {
+		t.Errorf("SVG() shows group names without ShowGroups")
+	}
+}
+
+func TestPNG(t *testing.T) {
+	m := model.NewCinema(log.StandardLogger(), 2, 4, 1).SeatMap("")
+	var buf bytes.Buffer
+	if err := PNG(&buf, m, Options{Overlay: true}); err != nil {
+		t.Fatal(err)
+	}
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := img.Bounds(); b.Dx() != 176 || b.Dy() != 172 {
+		t.Errorf("PNG() is %dx%d, want the size of the SVG", b.Dx(), b.Dy())
+	}
+}
+
+func TestPNG_TooLarge(t *testing.T) {
+	m := model.NewCinema(log.StandardLogger(), 10, 200, 1).SeatMap("")
+	var buf bytes.Buffer
+	if err := PNG(&buf, m, Options{}); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("PNG() of %d columns error = %v, want ErrTooLarge", m.Columns, err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("PNG() wrote %d bytes of a seat map too large", buf.Len())
+	}
+}
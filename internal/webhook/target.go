@@ -0,0 +1,105 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// sharedAddressSpace is the carrier-grade NAT range, not routable on the internet
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// internal tells if the address is not a public one: loopback, private, link-local (which holds
+// the cloud metadata endpoints), unspecified or multicast
+func internal(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() || addr.IsMulticast() ||
+		addr.IsUnspecified() || sharedAddressSpace.Contains(addr)
+}
+
+func (o *Options) allowed(host string) bool {
+	return slices.ContainsFunc(o.AllowedHosts, func(h string) bool { return strings.EqualFold(h, host) })
+}
+
+// checkURL refuses the urls a subscription must not target. Host names are resolved again
+// when dialing, see dialContext, so this only rejects what is internal on its face.
+func (o *Options) checkURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return fmt.Errorf("invalid webhook url %q", raw)
+	}
+	if u.Scheme != "https" && !o.AllowHTTP {
+		return fmt.Errorf("webhook url %q must use https", raw)
+	}
+	host := u.Hostname()
+	if o.allowed(host) {
+		return nil
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && internal(addr) {
+		return fmt.Errorf("webhook url %q targets an internal address", raw)
+	}
+	if name := strings.ToLower(strings.TrimSuffix(host, ".")); name == "localhost" || strings.HasSuffix(name, ".localhost") {
+		return fmt.Errorf("webhook url %q targets an internal address", raw)
+	}
+	return nil
+}
+
+// dialContext resolves the host and dials only its public addresses, unless the host is
+// allowed, so a name cannot be pointed at an internal address after subscribing
+func (o *Options) dialContext(dialer *net.Dialer) func(ctx context.Context, network, address string) (net.Conn, error) {
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, err
+		}
+		if o.allowed(host) {
+			return dialer.DialContext(ctx, network, address)
+		}
+		addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+		if err != nil {
+			return nil, err
+		}
+		for _, addr := range addrs {
+			if internal(addr) {
+				return nil, fmt.Errorf("webhook host %s resolves to internal address %s", host, addr)
+			}
+		}
+		var lastErr error
+		for _, addr := range addrs {
+			conn, err := dialer.DialContext(ctx, network, net.JoinHostPort(addr.Unmap().String(), port))
+			if err == nil {
+				return conn, nil
+			}
+			lastErr = err
+		}
+		if lastErr == nil {
+			lastErr = fmt.Errorf("webhook host %s has no address", host)
+		}
+		return nil, lastErr
+	}
+}
+
+// client sends the deliveries through dialContext and never through a proxy,
+// redirects are not followed since they could lead anywhere
+func (o *Options) client() *http.Client {
+	transport := &http.Transport{
+		DialContext:         o.dialContext(&net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}),
+		ForceAttemptHTTP2:   true,
+		MaxIdleConns:        100,
+		IdleConnTimeout:     90 * time.Second,
+		TLSHandshakeTimeout: 10 * time.Second,
+	}
+	return &http.Client{
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
@@ -0,0 +1,469 @@
+// Package webhook delivers cinema events to subscribed URLs as signed JSON payloads,
+// retrying failed deliveries with an exponential backoff before dead-lettering them.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Event types which can be subscribed to
+const (
+	CinemaConfigured = "CinemaConfigured"
+	ConfigUpdated    = "ConfigUpdated"
+	SeatsReserved    = "SeatsReserved"
+	SeatsCancelled   = "SeatsCancelled"
+	SeatsHeld        = "SeatsHeld"
+	HoldConfirmed    = "HoldConfirmed"
+	HoldReleased     = "HoldReleased"
+	WaitlistNotified = "WaitlistNotified"
+)
+
+var EventTypes = []string{
+	CinemaConfigured, ConfigUpdated, SeatsReserved, SeatsCancelled,
+	SeatsHeld, HoldConfirmed, HoldReleased, WaitlistNotified,
+}
+
+// Headers of every delivery. The signature is the hex HMAC-SHA256 of "<timestamp>.<body>"
+// keyed with the secret of the subscription, prefixed by "sha256=".
+const (
+	HeaderEvent     = "X-Seat-Event"
+	HeaderDelivery  = "X-Seat-Delivery"
+	HeaderTimestamp = "X-Seat-Timestamp"
+	HeaderSignature = "X-Seat-Signature"
+)
+
+type Status int
+
+const (
+	StatusPending Status = iota
+	StatusDelivered
+	StatusDead // every attempt failed
+)
+
+func (s Status) String() string {
+	switch s {
+	case StatusPending:
+		return "pending"
+	case StatusDelivered:
+		return "delivered"
+	case StatusDead:
+		return "dead"
+	}
+	return fmt.Sprintf("Status(%d)", int(s))
+}
+
+// Event is what is sent to the subscribers, Data is marshaled as JSON
+type Event struct {
+	Type     string    `json:"type"`
+	Tenant   string    `json:"tenant"`
+	CinemaID string    `json:"cinema_id"`
+	Version  int       `json:"version"`
+	Time     time.Time `json:"time"`
+	Data     any       `json:"data"`
+}
+
+// Subscription receives the events of the cinemas of its tenant
+type Subscription struct {
+	ID         string
+	Tenant     string
+	URL        string
+	EventTypes []string // every type if empty
+	CinemaID   string   // every cinema if empty
+	Secret     string
+	CreatedAt  time.Time
+}
+
+func (s *Subscription) matches(e Event) bool {
+	if s.Tenant != e.Tenant {
+		return false
+	}
+	if s.CinemaID != "" && s.CinemaID != e.CinemaID {
+		return false
+	}
+	return len(s.EventTypes) == 0 || slices.Contains(s.EventTypes, e.Type)
+}
+
+// Delivery is the sending of an event to a subscription
+type Delivery struct {
+	ID             string
+	SubscriptionID string
+	Tenant         string
+	EventType      string
+	CinemaID       string
+	Payload        []byte
+	Status         Status
+	Attempts       int
+	LastError      string
+	NextAttempt    time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+
+	inFlight bool
+}
+
+// Options tunes the dispatcher, zero fields take the defaults
+type Options struct {
+	MaxAttempts    int           // before dead-lettering, 6 by default
+	InitialBackoff time.Duration // doubled after every failure, 1s by default
+	MaxBackoff     time.Duration // 5m by default
+	Timeout        time.Duration // of every request, 10s by default
+	Workers        int           // concurrent deliveries, 4 by default
+	MaxDeliveries  int           // kept at most, the delivered then dead then pending ones are forgotten beyond, 10000 by default
+	// AllowedHosts may be targeted although they resolve to loopback, private or link-local
+	// addresses, which are refused otherwise
+	AllowedHosts []string
+	AllowHTTP    bool         // accepts http urls, for tests, only https otherwise
+	Client       *http.Client // only dials public addresses or AllowedHosts by default
+}
+
+func (o *Options) setDefaults() {
+	if o.MaxAttempts <= 0 {
+		o.MaxAttempts = 6
+	}
+	if o.InitialBackoff <= 0 {
+		o.InitialBackoff = time.Second
+	}
+	if o.MaxBackoff <= 0 {
+		o.MaxBackoff = 5 * time.Minute
+	}
+	if o.Timeout <= 0 {
+		o.Timeout = 10 * time.Second
+	}
+	if o.Workers <= 0 {
+		o.Workers = 4
+	}
+	if o.MaxDeliveries <= 0 {
+		o.MaxDeliveries = 10000
+	}
+	if o.Client == nil {
+		o.Client = o.client()
+	}
+}
+
+var ErrNotFound = errors.New("not found")
+
+type Dispatcher struct {
+	mu            sync.Mutex
+	logger        *log.Logger
+	opts          Options
+	subscriptions map[string]*Subscription
+	deliveries    map[string]*Delivery
+	order         []string // ids of the deliveries, oldest first
+	wake          chan struct{}
+}
+
+func NewDispatcher(l *log.Logger, opts Options) *Dispatcher {
+	opts.setDefaults()
+	return &Dispatcher{
+		logger:        l,
+		opts:          opts,
+		subscriptions: make(map[string]*Subscription),
+		deliveries:    make(map[string]*Delivery),
+		wake:          make(chan struct{}, 1),
+	}
+}
+
+func newID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// Subscribe registers a subscription, its ID and CreatedAt are set
+func (d *Dispatcher) Subscribe(s Subscription) (*Subscription, error) {
+	if err := d.opts.checkURL(s.URL); err != nil {
+		return nil, err
+	}
+	for _, t := range s.EventTypes {
+		if !slices.Contains(EventTypes, t) {
+			return nil, fmt.Errorf("unknown event type %q", t)
+		}
+	}
+	if s.Secret == "" {
+		return nil, errors.New("webhook secret must not be empty")
+	}
+	s.ID = newID()
+	s.CreatedAt = time.Now()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.subscriptions[s.ID] = &s
+	result := s
+	return &result, nil
+}
+
+func (d *Dispatcher) Unsubscribe(tenant, id string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if s, ok := d.subscriptions[id]; !ok || s.Tenant != tenant {
+		return fmt.Errorf("webhook %s %w", id, ErrNotFound)
+	}
+	delete(d.subscriptions, id)
+	return nil
+}
+
+// Subscriptions lists the subscriptions of the tenant, oldest first
+func (d *Dispatcher) Subscriptions(tenant string) []Subscription {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	result := make([]Subscription, 0)
+	for _, s := range d.subscriptions {
+		if s.Tenant == tenant {
+			result = append(result, *s)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].CreatedAt.Before(result[j].CreatedAt) })
+	return result
+}
+
+// Publish queues a delivery of the event for every matching subscription, it does not block
+func (d *Dispatcher) Publish(e Event) {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		d.logger.Errorf("marshal webhook event %s: %v", e.Type, err)
+		return
+	}
+	d.mu.Lock()
+	now := time.Now()
+	for _, s := range d.subscriptions {
+		if !s.matches(e) {
+			continue
+		}
+		delivery := &Delivery{
+			ID:             newID(),
+			SubscriptionID: s.ID,
+			Tenant:         s.Tenant,
+			EventType:      e.Type,
+			CinemaID:       e.CinemaID,
+			Payload:        payload,
+			NextAttempt:    now,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}
+		d.deliveries[delivery.ID] = delivery
+		d.order = append(d.order, delivery.ID)
+	}
+	d.prune()
+	d.mu.Unlock()
+	d.notify()
+}
+
+// prune forgets the oldest deliveries beyond MaxDeliveries, delivered ones first, then dead ones,
+// then pending ones so a subscriber which is down does not grow the store without bound
+func (d *Dispatcher) prune() {
+	for _, status := range []Status{StatusDelivered, StatusDead, StatusPending} {
+		for i := 0; len(d.order) > d.opts.MaxDeliveries && i < len(d.order); {
+			delivery := d.deliveries[d.order[i]]
+			if delivery.Status != status || delivery.inFlight {
+				i++
+				continue
+			}
+			if status == StatusPending {
+				d.logger.Warnf("webhook delivery %s to %s dropped, more than %d deliveries are kept", delivery.ID, delivery.SubscriptionID, d.opts.MaxDeliveries)
+			}
+			delete(d.deliveries, delivery.ID)
+			d.order = slices.Delete(d.order, i, i+1)
+		}
+	}
+}
+
+func (d *Dispatcher) notify() {
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Deliveries lists the deliveries of a subscription of the tenant, or of every one if empty,
+// optionally only those with the given status, oldest first
+func (d *Dispatcher) Deliveries(tenant, subscriptionID string, status *Status) []Delivery {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	result := make([]Delivery, 0)
+	for _, id := range d.order {
+		delivery := d.deliveries[id]
+		if delivery.Tenant != tenant {
+			continue
+		}
+		if subscriptionID != "" && delivery.SubscriptionID != subscriptionID {
+			continue
+		}
+		if status != nil && delivery.Status != *status {
+			continue
+		}
+		result = append(result, *delivery)
+	}
+	return result
+}
+
+// Retry queues a dead delivery again with a fresh number of attempts
+func (d *Dispatcher) Retry(tenant, deliveryID string) error {
+	d.mu.Lock()
+	delivery, ok := d.deliveries[deliveryID]
+	if !ok || delivery.Tenant != tenant {
+		d.mu.Unlock()
+		return fmt.Errorf("delivery %s %w", deliveryID, ErrNotFound)
+	}
+	if delivery.Status != StatusDead {
+		d.mu.Unlock()
+		return fmt.Errorf("delivery %s is %s, only dead ones can be retried", deliveryID, delivery.Status)
+	}
+	delivery.Status = StatusPending
+	delivery.Attempts = 0
+	delivery.NextAttempt = time.Now()
+	delivery.UpdatedAt = time.Now()
+	d.mu.Unlock()
+	d.notify()
+	return nil
+}
+
+// Run sends the due deliveries until the context is done
+func (d *Dispatcher) Run(ctx context.Context) {
+	sem := make(chan struct{}, d.opts.Workers)
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		next := d.dispatch(ctx, sem)
+		wait := time.Until(next)
+		if next.IsZero() {
+			wait = time.Hour
+		}
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(max(wait, 0))
+		select {
+		case <-ctx.Done():
+			return
+		case <-d.wake:
+		case <-timer.C:
+		}
+	}
+}
+
+// dispatch starts the due deliveries and returns when the next one is due, zero if none
+func (d *Dispatcher) dispatch(ctx context.Context, sem chan struct{}) time.Time {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	now := time.Now()
+	var next time.Time
+	for _, id := range d.order {
+		delivery := d.deliveries[id]
+		if delivery.Status != StatusPending || delivery.inFlight {
+			continue
+		}
+		if delivery.NextAttempt.After(now) {
+			if next.IsZero() || delivery.NextAttempt.Before(next) {
+				next = delivery.NextAttempt
+			}
+			continue
+		}
+		subscription, ok := d.subscriptions[delivery.SubscriptionID]
+		if !ok {
+			delivery.Status = StatusDead
+			delivery.LastError = "webhook deleted"
+			delivery.UpdatedAt = now
+			continue
+		}
+		select {
+		case sem <- struct{}{}:
+		default:
+			// every worker is busy, try again shortly
+			return now.Add(10 * time.Millisecond)
+		}
+		delivery.inFlight = true
+		go func(delivery Delivery, subscription Subscription) {
+			defer func() { <-sem }()
+			err := d.send(ctx, &delivery, &subscription)
+			d.done(delivery.ID, err)
+		}(*delivery, *subscription)
+	}
+	return next
+}
+
+func (d *Dispatcher) send(ctx context.Context, delivery *Delivery, subscription *Subscription) error {
+	ctx, cancel := context.WithTimeout(ctx, d.opts.Timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderDelivery, delivery.ID)
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, Sign(subscription.Secret, timestamp, delivery.Payload))
+	res, err := d.opts.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 1<<16))
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", res.Status)
+	}
+	return nil
+}
+
+func (d *Dispatcher) done(id string, err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delivery, ok := d.deliveries[id]
+	if !ok {
+		return
+	}
+	delivery.inFlight = false
+	delivery.Attempts++
+	delivery.UpdatedAt = time.Now()
+	if err == nil {
+		delivery.Status = StatusDelivered
+		delivery.LastError = ""
+		return
+	}
+	delivery.LastError = err.Error()
+	if delivery.Attempts >= d.opts.MaxAttempts {
+		delivery.Status = StatusDead
+		d.logger.Warnf("webhook delivery %s to %s is dead after %d attempts: %v", id, delivery.SubscriptionID, delivery.Attempts, err)
+		return
+	}
+	backoff := d.opts.InitialBackoff << (delivery.Attempts - 1)
+	if backoff <= 0 || backoff > d.opts.MaxBackoff {
+		backoff = d.opts.MaxBackoff
+	}
+	delivery.NextAttempt = time.Now().Add(backoff)
+	d.notify()
+}
+
+// Sign returns the signature header of a payload
+func Sign(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature of a received payload, receivers may use it
+func Verify(secret, timestamp string, payload []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, payload)), []byte(signature))
+}
@@ -0,0 +1,178 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// waitFor polls the deliveries until one has the status
+func waitFor(t *testing.T, d *Dispatcher, status Status) Delivery {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if deliveries := d.Deliveries("acme", "", &status); len(deliveries) > 0 {
+			return deliveries[0]
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("no %s delivery, got %+v", status, d.Deliveries("acme", "", nil))
+	return Delivery{}
+}
+
+func TestDispatcher(t *testing.T) {
+	var calls atomic.Int32
+	var failures atomic.Int32
+	failures.Store(2)
+	received := make(chan Event, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !Verify("secret", r.Header.Get(HeaderTimestamp), body, r.Header.Get(HeaderSignature)) {
+			t.Errorf("invalid signature %q", r.Header.Get(HeaderSignature))
+		}
+		if calls.Add(1) <= failures.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var e Event
+		_ = json.Unmarshal(body, &e)
+		received <- e
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	d := NewDispatcher(log.StandardLogger(), Options{
+		MaxAttempts: 3, InitialBackoff: 10 * time.Millisecond,
+		AllowHTTP: true, AllowedHosts: []string{"127.0.0.1"},
+	})
+	go d.Run(ctx)
+
+	if _, err := d.Subscribe(Subscription{Tenant: "acme", URL: server.URL, Secret: "secret", EventTypes: []string{"Unknown"}}); err == nil {
+		t.Errorf("Subscribe() with an unknown event type succeeded")
+	}
+	subscription, err := d.Subscribe(Subscription{Tenant: "acme", URL: server.URL, Secret: "secret", EventTypes: []string{SeatsReserved}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// retried after two failures
+	d.Publish(Event{Type: SeatsCancelled, Tenant: "acme", CinemaID: "0"})
+	d.Publish(Event{Type: SeatsReserved, Tenant: "other", CinemaID: "0"})
+	d.Publish(Event{Type: SeatsReserved, Tenant: "acme", CinemaID: "0", Version: 2})
+	delivery := waitFor(t, d, StatusDelivered)
+	if delivery.Attempts != 3 || delivery.SubscriptionID != subscription.ID {
+		t.Errorf("delivery = %+v, want 3 attempts to %s", delivery, subscription.ID)
+	}
+	if e := <-received; e.Type != SeatsReserved || e.Version != 2 {
+		t.Errorf("received %+v", e)
+	}
+	if n := len(d.Deliveries("acme", "", nil)); n != 1 {
+		t.Errorf("%d deliveries, the unsubscribed event type and other tenants must not be delivered", n)
+	}
+	if n := len(d.Deliveries("other", "", nil)) + len(d.Subscriptions("other")); n != 0 {
+		t.Errorf("other tenant sees %d deliveries and subscriptions", n)
+	}
+
+	// dead-lettered after every attempt failed, then retried
+	failures.Store(100)
+	calls.Store(0)
+	d.Publish(Event{Type: SeatsReserved, Tenant: "acme", CinemaID: "0", Version: 3})
+	dead := waitFor(t, d, StatusDead)
+	if dead.Attempts != 3 || dead.LastError == "" {
+		t.Errorf("dead delivery = %+v", dead)
+	}
+	failures.Store(0)
+	if err := d.Retry("other", dead.ID); err == nil {
+		t.Errorf("Retry() of another tenant succeeded")
+	}
+	if err := d.Retry("acme", dead.ID); err != nil {
+		t.Fatal(err)
+	}
+	if e := <-received; e.Version != 3 {
+		t.Errorf("received %+v after retry", e)
+	}
+}
+
+func TestSubscribe_Targets(t *testing.T) {
+	tests := []struct {
+		url  string
+		opts Options
+		ok   bool
+	}{
+		{url: "https://hooks.example.com/seats", ok: true},
+		{url: "http://hooks.example.com/seats"},
+		{url: "http://hooks.example.com/seats", opts: Options{AllowHTTP: true}, ok: true},
+		{url: "ftp://hooks.example.com/seats"},
+		{url: "https://127.0.0.1/seats"},
+		{url: "https://localhost:8045/seats"},
+		{url: "https://[::1]/seats"},
+		{url: "https://[::ffff:10.0.0.1]/seats"},
+		{url: "https://10.1.2.3/seats"},
+		{url: "https://192.168.0.10/seats"},
+		{url: "https://169.254.169.254/latest/meta-data"},
+		{url: "https://100.64.0.1/seats"},
+		{url: "https://0.0.0.0/seats"},
+		{url: "https://10.1.2.3/seats", opts: Options{AllowedHosts: []string{"10.1.2.3"}}, ok: true},
+	}
+	for _, tt := range tests {
+		d := NewDispatcher(log.StandardLogger(), tt.opts)
+		_, err := d.Subscribe(Subscription{Tenant: "acme", URL: tt.url, Secret: "secret"})
+		if (err == nil) != tt.ok {
+			t.Errorf("Subscribe(%s) with %+v error = %v, want ok %v", tt.url, tt.opts, err, tt.ok)
+		}
+	}
+
+	// a name resolving to an internal address is refused when dialing
+	opts := Options{}
+	dial := opts.dialContext(&net.Dialer{})
+	if _, err := dial(context.Background(), "tcp", "localhost:1"); err == nil || !strings.Contains(err.Error(), "internal address") {
+		t.Errorf("dial localhost error = %v, want internal address", err)
+	}
+}
+
+func TestDispatcher_Prune(t *testing.T) {
+	d := NewDispatcher(log.StandardLogger(), Options{MaxDeliveries: 3})
+	if _, err := d.Subscribe(Subscription{Tenant: "acme", URL: "https://hooks.example.com", Secret: "secret"}); err != nil {
+		t.Fatal(err)
+	}
+	// not running, every delivery stays pending
+	for version := range 5 {
+		d.Publish(Event{Type: SeatsReserved, Tenant: "acme", CinemaID: "0", Version: version})
+	}
+	deliveries := d.Deliveries("acme", "", nil)
+	if len(deliveries) != 3 {
+		t.Fatalf("%d deliveries kept, want 3", len(deliveries))
+	}
+
+	// dead ones go before pending ones
+	d.mu.Lock()
+	d.deliveries[deliveries[2].ID].Status = StatusDead
+	d.mu.Unlock()
+	d.Publish(Event{Type: SeatsReserved, Tenant: "acme", CinemaID: "0", Version: 5})
+	dead := StatusDead
+	if n := len(d.Deliveries("acme", "", &dead)); n != 0 {
+		t.Errorf("%d dead deliveries kept, want them pruned first", n)
+	}
+	if n := len(d.Deliveries("acme", "", nil)); n != 3 {
+		t.Errorf("%d deliveries kept, want 3", n)
+	}
+}
+
+func TestStatus_String(t *testing.T) {
+	if s := StatusDead.String(); s != "dead" {
+		t.Errorf("StatusDead = %q", s)
+	}
+	if s := Status(7).String(); s != "Status(7)" {
+		t.Errorf("Status(7) = %q", s)
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"net"
 	"net/http"
@@ -13,8 +14,10 @@ import (
 	"github.com/spf13/viper"
 	"github.com/t3201v/seat-arrangement/controller"
 	"github.com/t3201v/seat-arrangement/gen/cinema"
+	"github.com/t3201v/seat-arrangement/internal/auth"
 	"github.com/t3201v/seat-arrangement/internal/libs/assert"
 	"github.com/t3201v/seat-arrangement/internal/libs/logger"
+	"github.com/t3201v/seat-arrangement/internal/webhook"
 	"github.com/t3201v/seat-arrangement/repository"
 	"github.com/t3201v/seat-arrangement/service"
 	"google.golang.org/grpc"
@@ -32,8 +35,21 @@ func init() {
 	viper.SetConfigType("yaml")
 	err := viper.ReadInConfig()
 	assert.NoError(err, "read config file failed")
+	// local settings and keys, not committed
+	viper.SetConfigName("config.local")
+	if err := viper.MergeInConfig(); err != nil && !errors.As(err, &viper.ConfigFileNotFoundError{}) {
+		assert.NoError(err, "read local config file failed")
+	}
 	urlGRPC = ":" + viper.GetString("port_grpc")
 	urlHTTP = ":" + viper.GetString("port_http")
+	viper.SetDefault("audit_file", "audit.log")
+	viper.SetDefault("audit_max_size_mb", 10)
+	viper.SetDefault("audit_max_backups", 5)
+	viper.SetDefault("webhook_max_attempts", 6)
+	viper.SetDefault("webhook_initial_backoff", "1s")
+	viper.SetDefault("webhook_timeout", "10s")
+	viper.SetDefault("webhook_allow_http", false)
+	viper.SetDefault("legacy_ids", false)
 	grpcServerEndpoint = flag.String("grpc-server-endpoint", urlGRPC, "gRPC server endpoint")
 	assert.NotNilf(grpcServerEndpoint, "malformed gRPC server endpoint")
 }
@@ -48,8 +64,25 @@ func main() {
 			ForceColors:   true,
 		},
 	})
-	go startGRPC(l)
-	go startHTTP(l)
+	repo := repository.NewCinema(l, viper.GetBool("legacy_ids"))
+	audits, err := repository.NewAudit(l, viper.GetString("audit_file"), viper.GetInt64("audit_max_size_mb")<<20, viper.GetInt("audit_max_backups"))
+	assert.NoError(err, "open audit log failed")
+	webhooks := webhook.NewDispatcher(l, webhook.Options{
+		MaxAttempts:    viper.GetInt("webhook_max_attempts"),
+		InitialBackoff: viper.GetDuration("webhook_initial_backoff"),
+		Timeout:        viper.GetDuration("webhook_timeout"),
+		AllowedHosts:   viper.GetStringSlice("webhook_allowed_hosts"),
+		AllowHTTP:      viper.GetBool("webhook_allow_http"),
+	})
+	go webhooks.Run(context.Background())
+	var keyConfigs []auth.KeyConfig
+	err = viper.UnmarshalKey("api_keys", &keyConfigs)
+	assert.NoError(err, "read api keys failed")
+	keys, err := auth.NewKeys(keyConfigs)
+	assert.NoError(err, "invalid api keys")
+	svc := service.NewCinema(l, repo, repository.NewVenue(l), audits, webhooks)
+	go startGRPC(l, svc, keys)
+	go startHTTP(l, svc, keys)
 
 	// loop forever
 	var wg sync.WaitGroup
@@ -57,7 +90,7 @@ func main() {
 	wg.Wait()
 }
 
-func startGRPC(l *log.Logger) {
+func startGRPC(l *log.Logger, svc service.ICinema, keys *auth.Keys) {
 	l.Info("Starting gRPC server...")
 	lis, err := net.Listen("tcp", urlGRPC)
 	if err != nil {
@@ -65,10 +98,8 @@ func startGRPC(l *log.Logger) {
 	}
 
 	// server
-	s := grpc.NewServer()
+	s := grpc.NewServer(grpc.UnaryInterceptor(controller.AuthInterceptor(keys)))
 
-	repo := repository.NewCinema(l)
-	svc := service.NewCinema(l, repo)
 	impl := controller.NewCinema(l, svc)
 	cinema.RegisterCinemaServiceServer(s, impl)
 
@@ -79,7 +110,7 @@ func startGRPC(l *log.Logger) {
 	}
 }
 
-func startHTTP(l *log.Logger) {
+func startHTTP(l *log.Logger, svc service.ICinema, keys *auth.Keys) {
 	l.Info("Starting http server")
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -101,6 +132,11 @@ func startHTTP(l *log.Logger) {
 		w.Write([]byte("OK"))
 	})
 
+	// seat map images
+	render := controller.NewRender(l, svc, keys)
+	mux.HandleFunc("/api/v1/cinema/seat/map.svg", render.ServeSVG)
+	mux.HandleFunc("/api/v1/cinema/seat/map.png", render.ServePNG)
+
 	// swagger
 	mux.HandleFunc("/swagger.json", serveSwagger)
 	fs := http.FileServer(http.Dir("./www"))
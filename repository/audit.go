@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/t3201v/seat-arrangement/internal/model"
+)
+
+// append-only file storage, one JSON event per line
+type IAudit interface {
+	Append(event model.AuditEvent) error
+	List(filter model.AuditFilter) ([]model.AuditEvent, error)
+}
+
+// Audit writes events to a file which is rotated when it would grow over maxSize,
+// the rotated files are named path.1 (the most recent) up to path.<maxBackups>
+type Audit struct {
+	mu         sync.Mutex
+	logger     *log.Logger
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+func (a *Audit) Append(event model.AuditEvent) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.size > 0 && a.size+int64(len(line)) > a.maxSize {
+		if err := a.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := a.file.Write(line)
+	a.size += int64(n)
+	return err
+}
+
+func (a *Audit) rotate() error {
+	if err := a.file.Close(); err != nil {
+		return err
+	}
+	if a.maxBackups > 0 {
+		for i := a.maxBackups - 1; i >= 1; i-- {
+			err := os.Rename(a.backup(i), a.backup(i+1))
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return err
+			}
+		}
+		if err := os.Rename(a.path, a.backup(1)); err != nil {
+			return err
+		}
+	} else if err := os.Remove(a.path); err != nil {
+		return err
+	}
+	return a.open()
+}
+
+func (a *Audit) backup(i int) string {
+	return fmt.Sprintf("%s.%d", a.path, i)
+}
+
+func (a *Audit) open() error {
+	file, err := os.OpenFile(a.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o640)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	a.file = file
+	a.size = info.Size()
+	return nil
+}
+
+// List returns the matching events of the current and the rotated files, oldest first
+func (a *Audit) List(filter model.AuditFilter) ([]model.AuditEvent, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	events := make([]model.AuditEvent, 0)
+	paths := make([]string, 0, a.maxBackups+1)
+	for i := a.maxBackups; i >= 1; i-- {
+		paths = append(paths, a.backup(i))
+	}
+	paths = append(paths, a.path)
+	for _, path := range paths {
+		file, err := os.Open(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		scanner := bufio.NewScanner(file)
+		scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+		for scanner.Scan() {
+			var event model.AuditEvent
+			if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+				a.logger.Warnf("skip malformed audit event in %s: %v", path, err)
+				continue
+			}
+			if filter.Match(event) {
+				events = append(events, event)
+			}
+		}
+		err = scanner.Err()
+		file.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+func NewAudit(l *log.Logger, path string, maxSize int64, maxBackups int) (IAudit, error) {
+	if maxSize <= 0 {
+		return nil, errors.New("max size of the audit log must be positive")
+	}
+	a := &Audit{
+		mu:         sync.Mutex{},
+		logger:     l,
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+	if err := a.open(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
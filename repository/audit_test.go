@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/t3201v/seat-arrangement/internal/model"
+)
+
+func auditEvent(i int, tenant string) model.AuditEvent {
+	return model.AuditEvent{
+		RequestID: fmt.Sprintf("r%d", i),
+		Time:      time.Date(2024, 1, 1, 0, 0, i, 0, time.UTC),
+		Actor:     "alice",
+		Tenant:    tenant,
+		Operation: model.OpReserve,
+		CinemaID:  "c1",
+		Success:   true,
+	}
+}
+
+func requestIDs(events []model.AuditEvent) []string {
+	ids := make([]string, 0, len(events))
+	for _, e := range events {
+		ids = append(ids, e.RequestID)
+	}
+	return ids
+}
+
+func TestAudit_Rotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	line, err := json.Marshal(auditEvent(0, "acme"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// two events per file, events 0 to 9 have lines of the same length
+	audit, err := NewAudit(log.StandardLogger(), path, 2*int64(len(line)+1), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range 4 {
+		tenant := "acme"
+		if i%2 == 1 {
+			tenant = "beta"
+		}
+		if err := audit.Append(auditEvent(i, tenant)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// rotated once the limit would be crossed: path.1 holds 0 and 1, path holds 2 and 3
+	if _, err := os.Stat(path + ".1"); err != nil {
+		t.Fatalf("no rotated file at the size limit: %v", err)
+	}
+	if _, err := os.Stat(path + ".2"); err == nil {
+		t.Errorf("rotated twice for %d events", 4)
+	}
+	events, err := audit.List(model.AuditFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := fmt.Sprint(requestIDs(events)); got != "[r0 r1 r2 r3]" {
+		t.Errorf("List() = %s, want every event oldest first across files", got)
+	}
+
+	// filtered across the rotated and the current file
+	events, err = audit.List(model.AuditFilter{Tenant: "beta"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := fmt.Sprint(requestIDs(events)); got != "[r1 r3]" {
+		t.Errorf("List(beta) = %s, want [r1 r3]", got)
+	}
+	events, err = audit.List(model.AuditFilter{Since: auditEvent(1, "").Time, Until: auditEvent(3, "").Time})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := fmt.Sprint(requestIDs(events)); got != "[r1 r2]" {
+		t.Errorf("List(since 1, until 3) = %s, want [r1 r2]", got)
+	}
+
+	// the oldest file is dropped beyond maxBackups
+	for i := 4; i < 8; i++ {
+		if err := audit.Append(auditEvent(i, "acme")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := os.Stat(path + ".3"); err == nil {
+		t.Errorf("more than 2 rotated files are kept")
+	}
+	events, err = audit.List(model.AuditFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := fmt.Sprint(requestIDs(events)); got != "[r2 r3 r4 r5 r6 r7]" {
+		t.Errorf("List() = %s, want the oldest file dropped", got)
+	}
+
+	// reopened, the size of the current file is taken over
+	reopened, err := NewAudit(log.StandardLogger(), path, 2*int64(len(line)+1), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := reopened.Append(auditEvent(8, "acme")); err != nil {
+		t.Fatal(err)
+	}
+	events, err = reopened.List(model.AuditFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := fmt.Sprint(requestIDs(events)); got != "[r4 r5 r6 r7 r8]" {
+		t.Errorf("List() after reopening = %s, want a rotation before the full file grows", got)
+	}
+}
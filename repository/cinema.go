@@ -2,66 +2,391 @@ package repository
 
 import (
 	"errors"
+	"fmt"
+	"reflect"
+	"sort"
 	"strconv"
 	"sync"
-	"sync/atomic"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/t3201v/seat-arrangement/internal/model"
 )
 
-// memory storage
+// Key identifies a cinema, ids are only unique within a tenant
+type Key struct {
+	Tenant string
+	ID     string
+}
+
+func (k Key) String() string {
+	return k.Tenant + "/" + k.ID
+}
+
+// ErrNotFound marks the errors of the cinemas, versions and venues the tenant does not have
+var ErrNotFound = errors.New("not found")
+
+// memory storage of the event stream of every cinema, along with its current state.
+// Cinemas of a tenant are not visible to the others. Ids are issued by NewID, the caller
+// checks them with ValidateID. Cinemas are locked one by one, calls on distinct cinemas run
+// in parallel.
 type ICinema interface {
-	GetCinema(id string) (*model.Cinema, error)
-	InsertCinema(*model.Cinema) (string, error)
-	UpdateCinema(string, *model.Cinema) error
+	// View calls fn with the current state of the cinema, fn must neither change nor keep it
+	View(key Key, fn func(entity *model.Cinema) error) error
+	// InsertCinema stores the events emitted by a new cinema
+	InsertCinema(tenant string, entity *model.Cinema) (string, error)
+	// Update calls fn with the current state of the cinema and stores the events it emits,
+	// when fn fails after emitting some the state is rebuilt from the stored events. Only the
+	// cinema is locked, fn may change it in place instead of working on a copy.
+	Update(key Key, fn func(entity *model.Cinema) error) error
+	// UpdateVersion is Update returning the version of the cinema once updated
+	UpdateVersion(key Key, fn func(entity *model.Cinema) error) (Version, error)
+	Events(key Key) ([]RecordedEvent, error)
+	// GetCinemaAt returns the version of the cinema stored at the given time
+	GetCinemaAt(key Key, at time.Time) (*model.Cinema, Version, error)
+	// GetCinemaVersion returns a version of the cinema, numbered from 1
+	GetCinemaVersion(key Key, number int) (*model.Cinema, Version, error)
+	ListVersions(key Key) ([]Version, error)
+	// List calls fn with the cinemas of the tenant in id order, from the first one after the given id
+	// or from the first one if empty, until fn returns false. fn must neither change nor keep them.
+	List(tenant, after string, fn func(id string, entity *model.Cinema) bool) error
+	// Delete calls fn with the current state of the cinema then removes it along with its
+	// events, unless fn fails
+	Delete(key Key, fn func(entity *model.Cinema) error) error
+	// Resolve returns the id of the cinema of the tenant with the numeric id of the previous
+	// versions, only known when the repository issues them
+	Resolve(tenant, legacyID string) (string, error)
+	// LegacyID returns the numeric id of the cinema, empty when the repository does not issue them
+	LegacyID(key Key) string
+	// Subscribe calls fn with the events stored by every insert or update
+	Subscribe(fn Subscriber)
 }
 
+// Subscriber receives newly stored events along with the state they lead to, it is called
+// under the lock of the cinema so it must neither block, keep the state nor call back
+type Subscriber func(key Key, entity *model.Cinema, events []RecordedEvent)
+
+// Version describes a stored state of a cinema, there is one version per event
+type Version struct {
+	Number int
+	Time   time.Time
+	Event  string // type of the event creating the version, e.g. SeatsReserved
+}
+
+type RecordedEvent struct {
+	Version
+	Event model.Event
+}
+
+// stream of a cinema, every cinema has its own lock so that changes to distinct cinemas do
+// not wait for each other
+type stream struct {
+	mu       sync.RWMutex
+	events   []RecordedEvent
+	state    *model.Cinema // fold of the events
+	legacyID string
+	deleted  bool // removed while the caller waited for the lock
+}
+
+// cinemas of a tenant
+type tenant struct {
+	cinemas map[string]*stream
+	counter int64             // last numeric id issued
+	legacy  map[string]string // ids by numeric id
+}
+
+// Cinema locks the map of the cinemas only to find or add one, then the cinema itself.
+// A cinema lock is never waited for while holding the map lock, the other way round is fine.
 type Cinema struct {
-	mu      sync.RWMutex
-	logger  *log.Logger
-	counter *int64
-	cinemas map[int64]*model.Cinema
+	mu          sync.RWMutex // guards tenants
+	logger      *log.Logger
+	legacyIDs   bool // also issue the numeric ids of the previous versions
+	tenants     map[string]*tenant
+	subMu       sync.RWMutex // guards subscribers
+	subscribers []Subscriber
 }
 
-func (c *Cinema) GetCinema(id string) (*model.Cinema, error) {
-	_id, err := strconv.ParseInt(id, 10, 64)
-	if err != nil {
-		return nil, errors.New("invalid id")
-	}
+// lookup returns the cinema of the tenant without locking it, cinemas of other tenants are
+// not found either
+func (c *Cinema) lookup(key Key) (*stream, error) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
-	if _, ok := c.cinemas[_id]; !ok {
-		return nil, nil
+	var s *stream
+	if t, ok := c.tenants[key.Tenant]; ok {
+		s = t.cinemas[key.ID]
+	}
+	if s == nil {
+		return nil, fmt.Errorf("%w id %s", ErrNotFound, key.ID)
+	}
+	return s, nil
+}
+
+// stream returns the cinema of the tenant locked for reading, or for writing with write,
+// along with the function unlocking it
+func (c *Cinema) stream(key Key, write bool) (*stream, func(), error) {
+	s, err := c.lookup(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	unlock := s.mu.RUnlock
+	if write {
+		s.mu.Lock()
+		unlock = s.mu.Unlock
+	} else {
+		s.mu.RLock()
 	}
-	return c.cinemas[_id].Clone(), nil
+	if s.deleted {
+		unlock()
+		return nil, nil, fmt.Errorf("%w id %s", ErrNotFound, key.ID)
+	}
+	return s, unlock, nil
 }
 
-func (c *Cinema) InsertCinema(entity *model.Cinema) (string, error) {
+// append records the events and returns them
+func (s *stream) append(events []model.Event) []RecordedEvent {
+	now := time.Now()
+	for _, e := range events {
+		s.events = append(s.events, RecordedEvent{
+			Version: Version{Number: len(s.events) + 1, Time: now, Event: reflect.TypeOf(e).Name()},
+			Event:   e,
+		})
+	}
+	return s.events[len(s.events)-len(events):]
+}
+
+func (c *Cinema) publish(key Key, s *stream, events []RecordedEvent) {
+	if len(events) == 0 {
+		return
+	}
+	c.subMu.RLock()
+	defer c.subMu.RUnlock()
+	for _, fn := range c.subscribers {
+		fn(key, s.state, events)
+	}
+}
+
+// fold rebuilds the cinema from the first n events
+func (c *Cinema) fold(s *stream, n int) (*model.Cinema, error) {
+	events := make([]model.Event, 0, n)
+	for _, e := range s.events[:n] {
+		events = append(events, e.Event)
+	}
+	return model.Replay(c.logger, events)
+}
+
+func (c *Cinema) View(key Key, fn func(entity *model.Cinema) error) error {
+	s, unlock, err := c.stream(key, false)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	return fn(s.state)
+}
+
+func (c *Cinema) InsertCinema(tenantID string, entity *model.Cinema) (string, error) {
+	events := entity.TakeEvents()
+	if len(events) == 0 {
+		return "", errors.New("new cinema without events")
+	}
+	if _, ok := events[0].(model.CinemaConfigured); !ok {
+		return "", fmt.Errorf("first event of a cinema must be CinemaConfigured, got %T", events[0])
+	}
+	s := &stream{state: entity}
+	recorded := s.append(events)
+	key := Key{Tenant: tenantID, ID: NewID()}
+	// the cinema is locked before it is visible, subscribers see its events before any update
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	c.mu.Lock()
-	defer c.mu.Unlock()
-	counter := atomic.LoadInt64(c.counter)
-	c.cinemas[counter] = entity
-	atomic.AddInt64(c.counter, 1)
-	return strconv.FormatInt(counter, 10), nil
+	t, ok := c.tenants[tenantID]
+	if !ok {
+		t = &tenant{cinemas: make(map[string]*stream), legacy: make(map[string]string), counter: -1}
+		c.tenants[tenantID] = t
+	}
+	if c.legacyIDs {
+		t.counter++
+		s.legacyID = strconv.FormatInt(t.counter, 10)
+		t.legacy[s.legacyID] = key.ID
+	}
+	t.cinemas[key.ID] = s
+	c.mu.Unlock()
+	c.publish(key, s, recorded)
+	return key.ID, nil
+}
+
+func (c *Cinema) Update(key Key, fn func(entity *model.Cinema) error) error {
+	_, err := c.UpdateVersion(key, fn)
+	return err
 }
 
-func (c *Cinema) UpdateCinema(id string, entity *model.Cinema) error {
-	_id, err := strconv.ParseInt(id, 10, 64)
+func (c *Cinema) UpdateVersion(key Key, fn func(entity *model.Cinema) error) (Version, error) {
+	s, unlock, err := c.stream(key, true)
+	if err != nil {
+		return Version{}, err
+	}
+	defer unlock()
+	err = fn(s.state)
+	events := s.state.TakeEvents()
+	if err != nil && len(events) > 0 {
+		// fn changed the state before failing, every change emits an event
+		state, foldErr := c.fold(s, len(s.events))
+		if foldErr != nil {
+			c.logger.Errorf("rebuild cinema %s failed: %v", key, foldErr)
+			return Version{}, errors.Join(err, foldErr)
+		}
+		s.state = state
+	}
 	if err != nil {
-		return errors.New("invalid id")
+		return Version{}, err
+	}
+	c.publish(key, s, s.append(events))
+	return s.events[len(s.events)-1].Version, nil
+}
+
+func (c *Cinema) Events(key Key) ([]RecordedEvent, error) {
+	s, unlock, err := c.stream(key, false)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	return append([]RecordedEvent(nil), s.events...), nil
+}
+
+func (c *Cinema) GetCinemaAt(key Key, at time.Time) (*model.Cinema, Version, error) {
+	s, unlock, err := c.stream(key, false)
+	if err != nil {
+		return nil, Version{}, err
+	}
+	defer unlock()
+	// first event recorded after the time
+	n := sort.Search(len(s.events), func(i int) bool { return s.events[i].Time.After(at) })
+	if n == 0 {
+		return nil, Version{}, fmt.Errorf("%w version of cinema %s at %s", ErrNotFound, key.ID, at.Format(time.RFC3339))
+	}
+	entity, err := c.fold(s, n)
+	if err != nil {
+		return nil, Version{}, err
+	}
+	return entity, s.events[n-1].Version, nil
+}
+
+func (c *Cinema) GetCinemaVersion(key Key, number int) (*model.Cinema, Version, error) {
+	s, unlock, err := c.stream(key, false)
+	if err != nil {
+		return nil, Version{}, err
+	}
+	defer unlock()
+	if number < 1 || number > len(s.events) {
+		return nil, Version{}, fmt.Errorf("%w version %d of cinema %s", ErrNotFound, number, key.ID)
+	}
+	entity, err := c.fold(s, number)
+	if err != nil {
+		return nil, Version{}, err
+	}
+	return entity, s.events[number-1].Version, nil
+}
+
+func (c *Cinema) ListVersions(key Key) ([]Version, error) {
+	s, unlock, err := c.stream(key, false)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	result := make([]Version, 0, len(s.events))
+	for _, e := range s.events {
+		result = append(result, e.Version)
+	}
+	return result, nil
+}
+
+func (c *Cinema) List(tenantID, after string, fn func(id string, entity *model.Cinema) bool) error {
+	c.mu.RLock()
+	t, ok := c.tenants[tenantID]
+	if !ok {
+		c.mu.RUnlock()
+		return nil
+	}
+	streams := make(map[string]*stream)
+	ids := make([]string, 0, len(t.cinemas))
+	for id, s := range t.cinemas {
+		if id > after {
+			ids = append(ids, id)
+			streams[id] = s
+		}
+	}
+	c.mu.RUnlock()
+	sort.Strings(ids)
+	for _, id := range ids {
+		if !c.visit(streams[id], id, fn) {
+			break
+		}
+	}
+	return nil
+}
+
+// visit calls fn with the cinema under its read lock, skipping it when deleted meanwhile
+func (c *Cinema) visit(s *stream, id string, fn func(id string, entity *model.Cinema) bool) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.deleted {
+		return true
+	}
+	return fn(id, s.state)
+}
+
+func (c *Cinema) Delete(key Key, fn func(entity *model.Cinema) error) error {
+	s, unlock, err := c.stream(key, true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	if err := fn(s.state); err != nil {
+		return err
 	}
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	c.cinemas[_id] = entity
+	t := c.tenants[key.Tenant]
+	delete(t.cinemas, key.ID)
+	if s.legacyID != "" {
+		delete(t.legacy, s.legacyID)
+	}
+	s.deleted = true
 	return nil
 }
 
-func NewCinema(l *log.Logger) ICinema {
+func (c *Cinema) Resolve(tenantID, legacyID string) (string, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if t, ok := c.tenants[tenantID]; ok {
+		if id, ok := t.legacy[legacyID]; ok {
+			return id, nil
+		}
+	}
+	return "", fmt.Errorf("%w id %s", ErrNotFound, legacyID)
+}
+
+func (c *Cinema) LegacyID(key Key) string {
+	// set before the cinema is visible and never changed
+	s, err := c.lookup(key)
+	if err != nil {
+		return ""
+	}
+	return s.legacyID
+}
+
+func (c *Cinema) Subscribe(fn Subscriber) {
+	c.subMu.Lock()
+	defer c.subMu.Unlock()
+	c.subscribers = append(c.subscribers, fn)
+}
+
+// NewCinema returns an empty repository, with legacyIDs it also numbers the cinemas of every
+// tenant like the previous versions did, so that clients may move to the new ids gradually
+func NewCinema(l *log.Logger, legacyIDs bool) ICinema {
 	return &Cinema{
-		mu:      sync.RWMutex{},
-		logger:  l,
-		counter: new(int64),
-		cinemas: make(map[int64]*model.Cinema),
+		mu:        sync.RWMutex{},
+		logger:    l,
+		legacyIDs: legacyIDs,
+		tenants:   make(map[string]*tenant),
 	}
 }
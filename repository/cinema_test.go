@@ -0,0 +1,244 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/t3201v/seat-arrangement/internal/model"
+)
+
+func TestCinema_TenantIsolation(t *testing.T) {
+	repo := NewCinema(log.StandardLogger(), true)
+	var published []Key
+	repo.Subscribe(func(key Key, _ *model.Cinema, _ []RecordedEvent) { published = append(published, key) })
+
+	acme, err := repo.InsertCinema("acme", model.NewCinema(log.StandardLogger(), 2, 2, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := repo.InsertCinema("other", model.NewCinema(log.StandardLogger(), 3, 3, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if acme == other {
+		t.Errorf("two cinemas with id %s", acme)
+	}
+	acmeLegacy, otherLegacy := repo.LegacyID(Key{Tenant: "acme", ID: acme}), repo.LegacyID(Key{Tenant: "other", ID: other})
+	if acmeLegacy != "0" || otherLegacy != "0" {
+		t.Errorf("legacy ids %s and %s, want both numbered from 0 in their tenant", acmeLegacy, otherLegacy)
+	}
+	if id, err := repo.Resolve("acme", "0"); err != nil || id != acme {
+		t.Errorf("Resolve(acme, 0) = %s, %v, want %s", id, err, acme)
+	}
+
+	err = repo.Update(Key{Tenant: "acme", ID: acme}, func(entity *model.Cinema) error {
+		return entity.ReserveSeats([][]int{{0, 0}}, "a", model.ReserveOptions{})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = repo.View(Key{Tenant: "other", ID: other}, func(entity *model.Cinema) error {
+		if n := len(entity.ListAvailableSeats()); n != 9 {
+			t.Errorf("cinema of other tenant has %d available seats, want 9", n)
+		}
+		return nil
+	})
+	for _, key := range []Key{{Tenant: "unknown", ID: acme}, {Tenant: "", ID: acme}} {
+		if _, err := repo.ListVersions(key); err == nil {
+			t.Errorf("ListVersions(%s) found a cinema of another tenant", key)
+		}
+	}
+	if len(published) != 3 || published[2] != (Key{Tenant: "acme", ID: acme}) {
+		t.Errorf("published %v", published)
+	}
+}
+
+func TestCinema_UpdateVersion(t *testing.T) {
+	repo := NewCinema(log.StandardLogger(), false)
+	id, err := repo.InsertCinema("acme", model.NewCinema(log.StandardLogger(), 2, 2, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := Key{Tenant: "acme", ID: id}
+	version, err := repo.UpdateVersion(key, func(entity *model.Cinema) error {
+		return entity.ReserveSeats([][]int{{0, 0}}, "a", model.ReserveOptions{})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	versions, err := repo.ListVersions(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last := versions[len(versions)-1]; version != last || version.Event != "SeatsReserved" {
+		t.Errorf("UpdateVersion() = %+v, want the last version %+v", version, last)
+	}
+	if _, err := repo.UpdateVersion(key, func(*model.Cinema) error { return errors.New("failed") }); err == nil {
+		t.Errorf("UpdateVersion() ignored the error of fn")
+	}
+}
+
+func TestCinema_ListDelete(t *testing.T) {
+	repo := NewCinema(log.StandardLogger(), false)
+	var inserted []string
+	for i := 0; i < 3; i++ {
+		id, _ := repo.InsertCinema("acme", model.NewCinema(log.StandardLogger(), 1, 1, 0))
+		inserted = append(inserted, id)
+	}
+	_, _ = repo.InsertCinema("other", model.NewCinema(log.StandardLogger(), 1, 1, 0))
+
+	list := func(after string) []string {
+		var ids []string
+		_ = repo.List("acme", after, func(id string, _ *model.Cinema) bool {
+			ids = append(ids, id)
+			return true
+		})
+		return ids
+	}
+	if ids := list(""); !reflect.DeepEqual(ids, inserted) {
+		t.Errorf("List() = %v, want the insertion order %v", ids, inserted)
+	}
+	if err := repo.Delete(Key{Tenant: "acme", ID: inserted[1]}, func(*model.Cinema) error { return errors.New("kept") }); err == nil {
+		t.Errorf("Delete() ignored the failure of fn")
+	}
+	_ = repo.Delete(Key{Tenant: "acme", ID: inserted[1]}, func(*model.Cinema) error { return nil })
+	if ids := list(inserted[0]); !reflect.DeepEqual(ids, inserted[2:]) {
+		t.Errorf("List() after deletion = %v", ids)
+	}
+	if legacy := repo.LegacyID(Key{Tenant: "acme", ID: inserted[0]}); legacy != "" {
+		t.Errorf("LegacyID() = %s without legacy ids", legacy)
+	}
+}
+
+func TestCinema_Concurrent(t *testing.T) {
+	repo := NewCinema(log.StandardLogger(), true)
+	var published atomic.Int64
+	repo.Subscribe(func(_ Key, _ *model.Cinema, events []RecordedEvent) { published.Add(int64(len(events))) })
+	keys := make([]Key, 4)
+	for i := range keys {
+		id, _ := repo.InsertCinema("acme", model.NewCinema(log.StandardLogger(), 8, 8, 0))
+		keys[i] = Key{Tenant: "acme", ID: id}
+	}
+
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		for row := 0; row < 8; row++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for col := 0; col < 8; col++ {
+					_ = repo.Update(key, func(entity *model.Cinema) error {
+						return entity.ReserveSeats([][]int{{row, col}}, fmt.Sprintf("g%d", row), model.ReserveOptions{})
+					})
+					_ = repo.List("acme", "", func(string, *model.Cinema) bool { return true })
+				}
+			}()
+		}
+		if i == len(keys)-1 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_ = repo.Delete(key, func(*model.Cinema) error { return nil })
+			}()
+		}
+	}
+	wg.Wait()
+
+	for _, key := range keys[:len(keys)-1] {
+		_ = repo.View(key, func(entity *model.Cinema) error {
+			if n := len(entity.ListAvailableSeats()); n != 0 {
+				t.Errorf("cinema %s has %d available seats after reserving all of them", key, n)
+			}
+			return nil
+		})
+	}
+	if _, err := repo.Events(keys[len(keys)-1]); err == nil {
+		t.Errorf("deleted cinema still found")
+	}
+	if n := published.Load(); n < 3*(1+64) {
+		t.Errorf("published %d events, want at least %d", n, 3*(1+64))
+	}
+}
+
+// benchmarkUpdate reserves and cancels seats of the cinemas from parallel goroutines
+func benchmarkUpdate(b *testing.B, cinemas int) {
+	repo := NewCinema(log.StandardLogger(), false)
+	keys := make([]Key, cinemas)
+	for i := range keys {
+		id, _ := repo.InsertCinema("acme", model.NewCinema(log.StandardLogger(), 50, 50, 0))
+		keys[i] = Key{Tenant: "acme", ID: id}
+	}
+	var next atomic.Int64
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		n := int(next.Add(1))
+		key, seat := keys[n%len(keys)], [][]int{{n % 50, n / 50 % 50}}
+		for pb.Next() {
+			_ = repo.Update(key, func(entity *model.Cinema) error {
+				if err := entity.ReserveSeats(seat, "g", model.ReserveOptions{}); err != nil {
+					return err
+				}
+				return entity.CancelSeats(seat)
+			})
+		}
+	})
+}
+
+func BenchmarkCinema_UpdateSameCinema(b *testing.B)      { benchmarkUpdate(b, 1) }
+func BenchmarkCinema_UpdateDistinctCinemas(b *testing.B) { benchmarkUpdate(b, 64) }
+
+func BenchmarkCinema_ViewWhileUpdating(b *testing.B) {
+	repo := NewCinema(log.StandardLogger(), false)
+	keys := make([]Key, 64)
+	for i := range keys {
+		id, _ := repo.InsertCinema("acme", model.NewCinema(log.StandardLogger(), 50, 50, 0))
+		keys[i] = Key{Tenant: "acme", ID: id}
+	}
+	var next atomic.Int64
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		n := int(next.Add(1))
+		key := keys[n%len(keys)]
+		for i := 0; pb.Next(); i++ {
+			if i%10 != 0 {
+				_ = repo.View(key, func(entity *model.Cinema) error {
+					_ = entity.ListAvailableSeats()
+					return nil
+				})
+				continue
+			}
+			_ = repo.Update(key, func(entity *model.Cinema) error {
+				// fails without changing the cinema, nothing to rebuild
+				return entity.CancelSeats([][]int{{0, 0}})
+			})
+		}
+	})
+}
+
+func TestNewID(t *testing.T) {
+	last := ""
+	for i := 0; i < 1000; i++ {
+		id := NewID()
+		if err := ValidateID(id); err != nil {
+			t.Fatalf("ValidateID(%s) = %v", id, err)
+		}
+		if id <= last {
+			t.Fatalf("NewID() = %s after %s", id, last)
+		}
+		last = id
+	}
+	for _, id := range []string{"", "0", strings.ToLower(last), "8" + last[1:], last[:25] + "U"} {
+		if ValidateID(id) == nil {
+			t.Errorf("ValidateID(%q) succeeded", id)
+		}
+	}
+	if got := encodeID([16]byte{15: 1}); got != "00000000000000000000000001" {
+		t.Errorf("encodeID() = %s", got)
+	}
+}
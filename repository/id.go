@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"crypto/rand"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ids are ULIDs: 48 bits of milliseconds then 80 random bits, written as 26 characters of
+// Crockford's base32 so that they sort by creation time
+const (
+	idLength   = 26
+	idAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+)
+
+var ids idGenerator
+
+// idGenerator issues increasing ids, ids of the same millisecond increment the random part
+type idGenerator struct {
+	mu   sync.Mutex
+	ms   int64
+	last [16]byte
+}
+
+func (g *idGenerator) next() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	ms := time.Now().UnixMilli()
+	if ms > g.ms {
+		g.ms = ms
+		_, _ = rand.Read(g.last[6:])
+	} else {
+		for i := len(g.last) - 1; i >= 6; i-- {
+			g.last[i]++
+			if g.last[i] != 0 {
+				break
+			}
+		}
+	}
+	for i := 0; i < 6; i++ {
+		g.last[i] = byte(g.ms >> (40 - 8*i))
+	}
+	return encodeID(g.last)
+}
+
+// encodeID writes the 128 bits as 26 characters of 5 bits, the first 2 bits being zero
+func encodeID(b [16]byte) string {
+	var out [idLength]byte
+	for i := range out {
+		v := 0
+		for bit := i*5 - 2; bit < i*5+3; bit++ {
+			v <<= 1
+			if bit >= 0 && b[bit/8]>>(7-bit%8)&1 == 1 {
+				v |= 1
+			}
+		}
+		out[i] = idAlphabet[v]
+	}
+	return string(out[:])
+}
+
+// NewID returns a new id, greater than the ids issued before
+func NewID() string {
+	return ids.next()
+}
+
+// ValidateID checks the format of an id issued by NewID
+func ValidateID(id string) error {
+	if len(id) != idLength || id[0] > '7' {
+		return errors.New("invalid id")
+	}
+	for i := 0; i < len(id); i++ {
+		if strings.IndexByte(idAlphabet, id[i]) < 0 {
+			return errors.New("invalid id")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/t3201v/seat-arrangement/internal/model"
+)
+
+// memory storage of the venues of every tenant, ids are issued by NewID
+type IVenue interface {
+	InsertVenue(tenant string, venue model.Venue) (string, error)
+	GetVenue(tenant, id string) (model.Venue, error)
+	// ListVenues returns the venues of the tenant sorted by id
+	ListVenues(tenant string) []model.Venue
+	// UpdateVenue calls fn with a copy of the venue and stores it when fn succeeds
+	UpdateVenue(tenant, id string, fn func(venue *model.Venue) error) error
+	DeleteVenue(tenant, id string) error
+}
+
+type Venue struct {
+	mu      sync.RWMutex
+	logger  *log.Logger
+	tenants map[string]map[string]model.Venue
+}
+
+func (v *Venue) venue(tenant, id string) (model.Venue, error) {
+	if venue, ok := v.tenants[tenant][id]; ok {
+		return venue, nil
+	}
+	return model.Venue{}, fmt.Errorf("%w venue %s", ErrNotFound, id)
+}
+
+func (v *Venue) InsertVenue(tenant string, venue model.Venue) (string, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if _, ok := v.tenants[tenant]; !ok {
+		v.tenants[tenant] = make(map[string]model.Venue)
+	}
+	venue.ID = NewID()
+	venue.Halls = slices.Clone(venue.Halls)
+	v.tenants[tenant][venue.ID] = venue
+	return venue.ID, nil
+}
+
+func (v *Venue) GetVenue(tenant, id string) (model.Venue, error) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	venue, err := v.venue(tenant, id)
+	venue.Halls = slices.Clone(venue.Halls)
+	return venue, err
+}
+
+func (v *Venue) ListVenues(tenant string) []model.Venue {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	venues := v.tenants[tenant]
+	ids := make([]string, 0, len(venues))
+	for id := range venues {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	result := make([]model.Venue, 0, len(ids))
+	for _, id := range ids {
+		venue := venues[id]
+		venue.Halls = slices.Clone(venue.Halls)
+		result = append(result, venue)
+	}
+	return result
+}
+
+func (v *Venue) UpdateVenue(tenant, id string, fn func(venue *model.Venue) error) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	venue, err := v.venue(tenant, id)
+	if err != nil {
+		return err
+	}
+	venue.Halls = slices.Clone(venue.Halls)
+	if err := fn(&venue); err != nil {
+		return err
+	}
+	venue.ID = id
+	v.tenants[tenant][id] = venue
+	return nil
+}
+
+func (v *Venue) DeleteVenue(tenant, id string) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if _, err := v.venue(tenant, id); err != nil {
+		return err
+	}
+	delete(v.tenants[tenant], id)
+	return nil
+}
+
+func NewVenue(l *log.Logger) IVenue {
+	return &Venue{
+		logger:  l,
+		tenants: make(map[string]map[string]model.Venue),
+	}
+}
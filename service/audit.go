@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/t3201v/seat-arrangement/gen/cinema"
+	"github.com/t3201v/seat-arrangement/internal/auth"
+	"github.com/t3201v/seat-arrangement/internal/model"
+	"google.golang.org/grpc/metadata"
+)
+
+const requestIDMetadataKey = "x-request-id"
+
+// actor names the caller by its api key, "anonymous" when not authenticated.
+// Nothing the caller sends in its metadata is trusted for it.
+func actor(ctx context.Context) string {
+	if p, ok := auth.FromContext(ctx); ok && p.Name != "" {
+		return p.Name
+	}
+	return "anonymous"
+}
+
+// requestID returns the id given by the caller, or a new one
+func requestID(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(requestIDMetadataKey); len(values) > 0 && values[0] != "" {
+		return values[0]
+	}
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func auditSeats(seats []*cinema.Seat) []model.AuditSeat {
+	result := make([]model.AuditSeat, 0, len(seats))
+	for _, seat := range seats {
+		if seat == nil {
+			continue
+		}
+		result = append(result, model.AuditSeat{Row: int(seat.Row), Column: int(seat.Column), Label: seat.Label})
+	}
+	return result
+}
+
+// audit records the outcome of an operation, a failing audit log does not fail the operation
+func (c *Cinema) audit(ctx context.Context, events ...model.AuditEvent) {
+	now := time.Now()
+	id := requestID(ctx)
+	who := actor(ctx)
+	org := tenant(ctx)
+	for _, event := range events {
+		event.RequestID = id
+		event.Time = now
+		event.Actor = who
+		event.Tenant = org
+		if err := c.audits.Append(event); err != nil {
+			c.logger.Errorf("audit %s of cinema %s failed: %v", event.Operation, event.CinemaID, err)
+		}
+	}
+}
+
+func outcome(event model.AuditEvent, err error) model.AuditEvent {
+	event.Success = err == nil
+	if err != nil {
+		event.Error = err.Error()
+	}
+	return event
+}
+
+func (c *Cinema) ListAuditEvents(ctx context.Context, request *cinema.ListAuditEventsRequest) ([]model.AuditEvent, error) {
+	filter := model.AuditFilter{
+		Tenant:   tenant(ctx),
+		CinemaID: request.CinemaId,
+		Actor:    request.Actor,
+	}
+	if request.Since != nil {
+		filter.Since = request.Since.AsTime()
+	}
+	if request.Until != nil {
+		filter.Until = request.Until.AsTime()
+	}
+	events, err := c.audits.List(filter)
+	if err != nil {
+		c.logger.Error(err)
+		return nil, err
+	}
+	return events, nil
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/t3201v/seat-arrangement/gen/cinema"
+	"github.com/t3201v/seat-arrangement/internal/model"
+	"github.com/t3201v/seat-arrangement/repository"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+// page tokens hold the id of the last cinema of the page
+func encodePageToken(id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(id))
+}
+
+func decodePageToken(token string) (string, error) {
+	id, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || (len(id) > 0 && repository.ValidateID(string(id)) != nil) {
+		return "", fmt.Errorf("%w: invalid page token", ErrInvalidArgument)
+	}
+	return string(id), nil
+}
+
+func matchesFilter(summary model.Summary, request *cinema.ListCinemasRequest) bool {
+	if request.NameContains != "" && !strings.Contains(strings.ToLower(summary.Name), strings.ToLower(request.NameContains)) {
+		return false
+	}
+	if summary.Seats < int(request.MinSeats) || (request.MaxSeats > 0 && summary.Seats > int(request.MaxSeats)) {
+		return false
+	}
+	occupancy := summary.Occupancy()
+	return occupancy >= request.MinOccupancy && (request.MaxOccupancy <= 0 || occupancy <= request.MaxOccupancy)
+}
+
+// ListCinemas returns a page of the cinemas of the caller matching the filter, and the token
+// of the next page if any
+func (c *Cinema) ListCinemas(ctx context.Context, request *cinema.ListCinemasRequest) ([]model.Summary, string, error) {
+	if request.PageSize < 0 {
+		return nil, "", fmt.Errorf("%w: page size must not be negative", ErrInvalidArgument)
+	}
+	size := defaultPageSize
+	if request.PageSize > 0 {
+		size = min(int(request.PageSize), maxPageSize)
+	}
+	after, err := decodePageToken(request.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+	summaries := make([]model.Summary, 0)
+	more := false
+	err = c.repo.List(tenant(ctx), after, func(id string, entity *model.Cinema) bool {
+		summary := entity.Summary()
+		if !matchesFilter(summary, request) {
+			return true
+		}
+		if len(summaries) == size {
+			more = true
+			return false
+		}
+		summary.ID = id
+		summaries = append(summaries, summary)
+		return true
+	})
+	if err != nil {
+		c.logger.Error(err)
+		return nil, "", err
+	}
+	for i := range summaries {
+		summaries[i].LegacyID = c.repo.LegacyID(repository.Key{Tenant: tenant(ctx), ID: summaries[i].ID})
+	}
+	next := ""
+	if more {
+		next = encodePageToken(summaries[len(summaries)-1].ID)
+	}
+	return summaries, next, nil
+}
+
+// DeleteCinema deletes the cinema and takes it out of its venue, cinemas with reservations
+// are only deleted when forced
+func (c *Cinema) DeleteCinema(ctx context.Context, request *cinema.DeleteCinemaRequest) (err error) {
+	defer func() {
+		c.audit(ctx, outcome(model.AuditEvent{Operation: model.OpDelete, CinemaID: request.Id}, err))
+	}()
+	key, err := c.key(ctx, request.Id)
+	if err != nil {
+		return err
+	}
+	remove := func() error {
+		return c.repo.Delete(key, func(entity *model.Cinema) error {
+			if !request.Force && entity.HasReservations() {
+				return fmt.Errorf("%w: cinema %s has reservations, force to delete it", ErrInvalidArgument, request.Id)
+			}
+			return nil
+		})
+	}
+	venueID := ""
+	for _, venue := range c.venues.ListVenues(tenant(ctx)) {
+		if slices.Contains(venue.Halls, key.ID) {
+			venueID = venue.ID
+		}
+	}
+	if venueID == "" {
+		err = remove()
+	} else {
+		err = c.venues.UpdateVenue(tenant(ctx), venueID, func(venue *model.Venue) error {
+			if err := remove(); err != nil {
+				return err
+			}
+			venue.Halls = slices.DeleteFunc(venue.Halls, func(id string) bool { return id == key.ID })
+			return nil
+		})
+	}
+	if err != nil {
+		c.logger.Error(err)
+		return err
+	}
+	return nil
+}
@@ -1,32 +1,150 @@
 package service
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/t3201v/seat-arrangement/gen/cinema"
 	"github.com/t3201v/seat-arrangement/internal/model"
+	"github.com/t3201v/seat-arrangement/internal/webhook"
 	"github.com/t3201v/seat-arrangement/repository"
 )
 
 type ICinema interface {
 	ConfigureCinema(ctx context.Context, request *cinema.ConfigureCinemaRequest) (string, error)
 	UpdateCinemaConfig(ctx context.Context, request *cinema.UpdateCinemaConfigRequest) error
-	GetAvailableSeats(ctx context.Context, request *cinema.GetAvailableSeatsRequest) ([][]int, string, error)
-	ReserveSeats(ctx context.Context, request *cinema.ReserveSeatsRequest) error
+	ExportCinema(ctx context.Context, request *cinema.ExportCinemaRequest) ([]byte, error)
+	ImportCinema(ctx context.Context, request *cinema.ImportCinemaRequest) (string, error)
+	ListCinemas(ctx context.Context, request *cinema.ListCinemasRequest) ([]model.Summary, string, error)
+	DeleteCinema(ctx context.Context, request *cinema.DeleteCinemaRequest) error
+	GetAvailableSeats(ctx context.Context, request *cinema.GetAvailableSeatsRequest) ([]*cinema.Seat, string, error)
+	GetSeatMap(ctx context.Context, request *cinema.GetSeatMapRequest) (*model.SeatMap, error)
+	ExplainSeat(ctx context.Context, request *cinema.ExplainSeatRequest) (*model.SeatExplanation, error)
+	ListDistancingPolicies(ctx context.Context, request *cinema.ListDistancingPoliciesRequest) ([]model.DistancingPolicy, *model.DistancingPolicy, error)
+	GetPolicyViolations(ctx context.Context, request *cinema.GetPolicyViolationsRequest) (model.DistancingPolicy, []model.PolicyViolation, error)
+	ReserveSeats(ctx context.Context, request *cinema.ReserveSeatsRequest) (*model.PriceBreakdown, error)
 	CancelSeats(ctx context.Context, request *cinema.CancelSeatsRequest) error
+	SuggestSeats(ctx context.Context, request *cinema.SuggestSeatsRequest) ([]*cinema.Seat, error)
+	BatchReserve(ctx context.Context, request *cinema.BatchReserveRequest) ([]*model.PriceBreakdown, []error, error)
+	PlanSeating(ctx context.Context, request *cinema.PlanSeatingRequest) (*model.SeatingPlan, bool, error)
+	AnalyzeCapacity(ctx context.Context, request *cinema.AnalyzeCapacityRequest) (*model.CapacityReport, error)
+	ListAuditEvents(ctx context.Context, request *cinema.ListAuditEventsRequest) ([]model.AuditEvent, error)
+	ListCinemaVersions(ctx context.Context, request *cinema.ListCinemaVersionsRequest) ([]repository.Version, error)
+	DiffCinema(ctx context.Context, request *cinema.DiffCinemaRequest) (int, int, []model.SeatChange, error)
+	RevertCinema(ctx context.Context, request *cinema.RevertCinemaRequest) (int, []model.SeatChange, error)
+	// Read and Apply run fn under the lock of a single cinema, for changes spanning several
+	// calls of the model
+	Read(ctx context.Context, id string, fn func(entity *model.Cinema) error) error
+	Apply(ctx context.Context, id string, fn func(entity *model.Cinema) error) error
+	JoinWaitlist(ctx context.Context, request *cinema.JoinWaitlistRequest) (int, int, error)
+	LeaveWaitlist(ctx context.Context, request *cinema.WaitlistGroupRequest) error
+	GetWaitlistPosition(ctx context.Context, request *cinema.WaitlistGroupRequest) (int, int, *model.Hold, error)
+	GetWaitlist(ctx context.Context, request *cinema.GetWaitlistRequest) ([]model.WaitlistEntry, []model.Hold, error)
+	ConfirmHold(ctx context.Context, request *cinema.WaitlistGroupRequest) error
+	ReleaseHold(ctx context.Context, request *cinema.WaitlistGroupRequest) error
+	CreateVenue(ctx context.Context, request *cinema.CreateVenueRequest) (*model.Venue, error)
+	ListVenues(ctx context.Context, request *cinema.ListVenuesRequest) ([]model.Venue, error)
+	DeleteVenue(ctx context.Context, request *cinema.DeleteVenueRequest) error
+	CreateWebhook(ctx context.Context, request *cinema.CreateWebhookRequest) (*webhook.Subscription, error)
+	ListWebhooks(ctx context.Context, request *cinema.ListWebhooksRequest) ([]webhook.Subscription, error)
+	DeleteWebhook(ctx context.Context, request *cinema.DeleteWebhookRequest) error
+	ListWebhookDeliveries(ctx context.Context, request *cinema.ListWebhookDeliveriesRequest) ([]webhook.Delivery, error)
+	RetryWebhookDelivery(ctx context.Context, request *cinema.RetryWebhookDeliveryRequest) error
 }
 
+// ErrInvalidArgument marks the errors of malformed requests
+var ErrInvalidArgument = errors.New("invalid argument")
+
+// invalid marks err as an invalid argument, unless it already is one or tells what is not found
+func invalid(err error) error {
+	if err == nil || errors.Is(err, ErrInvalidArgument) || errors.Is(err, repository.ErrNotFound) || errors.Is(err, webhook.ErrNotFound) {
+		return err
+	}
+	return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
+}
+
+const (
+	defaultPlanTimeout = 2 * time.Second
+	maxPlanTimeout     = 30 * time.Second
+)
+
 type Cinema struct {
-	logger *log.Logger
-	repo   repository.ICinema
+	logger   *log.Logger
+	repo     repository.ICinema
+	venues   repository.IVenue
+	audits   repository.IAudit
+	webhooks *webhook.Dispatcher
 }
 
-func (c *Cinema) ConfigureCinema(ctx context.Context, request *cinema.ConfigureCinemaRequest) (string, error) {
+func (c *Cinema) ConfigureCinema(ctx context.Context, request *cinema.ConfigureCinemaRequest) (id string, err error) {
+	defer func() {
+		c.audit(ctx, outcome(model.AuditEvent{Operation: model.OpConfigure, CinemaID: id}, err))
+	}()
+	if err = model.ValidateConfig(int(request.Rows), int(request.Columns), int(request.MinDistance)); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+	}
 	entity := model.NewCinema(c.logger, int(request.Rows), int(request.Columns), int(request.MinDistance))
-	id, err := c.repo.InsertCinema(entity)
+	if request.Name != "" || request.Description != "" {
+		err := entity.SetDescription(request.Name, request.Description)
+		if err != nil {
+			return "", invalid(err)
+		}
+	}
+	if request.Labels != nil {
+		err := entity.SetLabelScheme(toLabelScheme(request.Labels))
+		if err != nil {
+			return "", invalid(err)
+		}
+	}
+	if request.Adjacency != nil {
+		err := entity.SetAdjacencyPolicy(toAdjacencyPolicy(request.Adjacency))
+		if err != nil {
+			return "", invalid(err)
+		}
+	}
+	if request.Separation != nil {
+		err := entity.SetSeparation(toSeparationProfile(request.Separation))
+		if err != nil {
+			return "", invalid(err)
+		}
+	}
+	if len(request.Policies) > 0 {
+		policies, err := toDistancingPolicies(request.Policies)
+		if err != nil {
+			return "", invalid(err)
+		}
+		err = entity.SetDistancingPolicies(policies, time.Now())
+		if err != nil {
+			return "", invalid(err)
+		}
+	}
+	err = c.applyCategories(entity, request.Categories)
+	if err != nil {
+		return "", invalid(err)
+	}
+	err = c.applyPrices(entity, request.Currency, request.Prices)
+	if err != nil {
+		return "", invalid(err)
+	}
+	if request.ShowTime != nil {
+		err = entity.SetShowTime(request.ShowTime.AsTime(), request.AccessibleRelease.AsDuration())
+		if err != nil {
+			return "", invalid(err)
+		}
+	}
+	unsellable, err := entity.FromPbSeats(request.UnsellableSeats)
+	if err != nil {
+		return "", invalid(err)
+	}
+	err = entity.SetUnsellable(unsellable)
+	if err != nil {
+		return "", invalid(err)
+	}
+	id, err = c.insertCinema(ctx, request.VenueId, entity)
 	if err != nil {
 		c.logger.Error(err)
 		return "", err
@@ -34,100 +152,535 @@ func (c *Cinema) ConfigureCinema(ctx context.Context, request *cinema.ConfigureC
 	return id, nil
 }
 
-func (c *Cinema) UpdateCinemaConfig(ctx context.Context, request *cinema.UpdateCinemaConfigRequest) error {
-	entity, err := c.repo.GetCinema(request.Id)
+func (c *Cinema) UpdateCinemaConfig(ctx context.Context, request *cinema.UpdateCinemaConfigRequest) (err error) {
+	defer func() {
+		c.audit(ctx, outcome(model.AuditEvent{
+			Operation: model.OpUpdate,
+			CinemaID:  request.Id,
+			Seats:     auditSeats(request.UnsellableSeats),
+		}, err))
+	}()
+	err = c.Apply(ctx, request.Id, func(entity *model.Cinema) error {
+		if err := entity.UpdateConfig(int(request.Rows), int(request.Columns), int(request.MinDistance)); err != nil {
+			return err
+		}
+		if request.Name != "" || request.Description != "" {
+			name, description := entity.Description()
+			err := entity.SetDescription(cmp.Or(request.Name, name), cmp.Or(request.Description, description))
+			if err != nil {
+				return err
+			}
+		}
+		if request.Labels != nil {
+			err := entity.SetLabelScheme(toLabelScheme(request.Labels))
+			if err != nil {
+				return err
+			}
+		}
+		if request.Adjacency != nil {
+			err := entity.SetAdjacencyPolicy(toAdjacencyPolicy(request.Adjacency))
+			if err != nil {
+				return err
+			}
+		}
+		if request.Separation != nil {
+			err := entity.SetSeparation(toSeparationProfile(request.Separation))
+			if err != nil {
+				return err
+			}
+		}
+		if len(request.Policies) > 0 || request.ClearPolicies {
+			policies, err := toDistancingPolicies(request.Policies)
+			if err != nil {
+				return err
+			}
+			err = entity.SetDistancingPolicies(policies, time.Now())
+			if err != nil {
+				return err
+			}
+		}
+		if len(request.Categories) > 0 {
+			entity.ResetSeatCategories()
+			err := c.applyCategories(entity, request.Categories)
+			if err != nil {
+				return err
+			}
+		}
+		if len(request.Prices) > 0 {
+			err := c.applyPrices(entity, request.Currency, request.Prices)
+			if err != nil {
+				return err
+			}
+		}
+		if len(request.UnsellableSeats) > 0 {
+			unsellable, err := entity.FromPbSeats(request.UnsellableSeats)
+			if err != nil {
+				return err
+			}
+			entity.ResetUnsellable()
+			err = entity.SetUnsellable(unsellable)
+			if err != nil {
+				return err
+			}
+		}
+		if request.ShowTime != nil || request.AccessibleRelease != nil {
+			showTime, release := entity.ShowTime()
+			if request.ShowTime != nil {
+				showTime = request.ShowTime.AsTime()
+			}
+			if request.AccessibleRelease != nil {
+				release = request.AccessibleRelease.AsDuration()
+			}
+			return entity.SetShowTime(showTime, release)
+		}
+		return nil
+	})
 	if err != nil {
 		c.logger.Error(err)
 		return err
 	}
-	if entity == nil {
-		return fmt.Errorf("not found id %s", request.Id)
+	return nil
+}
+
+func (c *Cinema) ExportCinema(ctx context.Context, request *cinema.ExportCinemaRequest) ([]byte, error) {
+	var layout *model.Layout
+	err := c.Read(ctx, request.Id, func(entity *model.Cinema) error {
+		layout = entity.Export(request.IncludeReservations)
+		return nil
+	})
+	if err != nil {
+		c.logger.Error(err)
+		return nil, err
+	}
+	data, err := model.EncodeLayout(layout, model.LayoutFormat(request.Format))
+	if err != nil {
+		return nil, invalid(err)
 	}
+	return data, nil
+}
 
-	entity.UpdateConfig(int(request.Rows), int(request.Columns), int(request.MinDistance))
-	err = c.repo.UpdateCinema(request.Id, entity)
+func (c *Cinema) ImportCinema(ctx context.Context, request *cinema.ImportCinemaRequest) (id string, err error) {
+	defer func() {
+		c.audit(ctx, outcome(model.AuditEvent{Operation: model.OpImport, CinemaID: id}, err))
+	}()
+	layout, err := model.DecodeLayout([]byte(request.Data), model.LayoutFormat(request.Format), int(request.MinDistance))
+	if err != nil {
+		return "", fmt.Errorf("%w: malformed layout: %w", ErrInvalidArgument, err)
+	}
+	entity, err := model.Import(c.logger, layout)
+	if err != nil {
+		return "", invalid(err)
+	}
+	id, err = c.insertCinema(ctx, request.VenueId, entity)
 	if err != nil {
 		c.logger.Error(err)
-		return err
+		return "", err
 	}
-	return nil
+	return id, nil
 }
 
-func (c *Cinema) GetAvailableSeats(ctx context.Context, request *cinema.GetAvailableSeatsRequest) ([][]int, string, error) {
-	entity, err := c.repo.GetCinema(request.Id)
+func (c *Cinema) GetAvailableSeats(ctx context.Context, request *cinema.GetAvailableSeatsRequest) ([]*cinema.Seat, string, error) {
+	categories := make([]model.SeatCategory, 0, len(request.Categories))
+	for _, category := range request.Categories {
+		categories = append(categories, model.SeatCategory(category))
+	}
+	var seats []*cinema.Seat
+	var grid string
+	err := c.Read(ctx, request.Id, func(entity *model.Cinema) error {
+		var err error
+		seats, err = entity.ToPbSeats(entity.ListAvailableSeats(categories...))
+		grid = entity.String()
+		return err
+	})
 	if err != nil {
 		c.logger.Error(err)
 		return nil, "", err
 	}
-	if entity == nil {
-		return nil, "", fmt.Errorf("not found id %s", request.Id)
-	}
-	return entity.ListAvailableSeatsGrouped(), entity.String(), nil
+	return seats, grid, nil
 }
 
-func (c *Cinema) ReserveSeats(ctx context.Context, request *cinema.ReserveSeatsRequest) error {
-	entity, err := c.repo.GetCinema(request.Id)
+func (c *Cinema) GetSeatMap(ctx context.Context, request *cinema.GetSeatMapRequest) (*model.SeatMap, error) {
+	if request.AsOf != nil {
+		entity, _, err := c.cinemaAt(ctx, request.Id, request.AsOf)
+		if err != nil {
+			return nil, err
+		}
+		return entity.SeatMap(request.GroupName), nil
+	}
+	var seatMap *model.SeatMap
+	err := c.Read(ctx, request.Id, func(entity *model.Cinema) error {
+		seatMap = entity.SeatMap(request.GroupName)
+		return nil
+	})
 	if err != nil {
 		c.logger.Error(err)
-		return err
-	}
-	if entity == nil {
-		return fmt.Errorf("not found id %s", request.Id)
+		return nil, err
 	}
+	return seatMap, nil
+}
 
-	seats := make([][]int, 0)
-	for _, seat := range request.SeatCoords {
-		if seat == nil {
-			return errors.New("malformed seat data")
-		}
-		seats = append(seats, []int{int(seat.Row), int(seat.Column)})
+// ExplainSeat tells whether the group can reserve the seat under the rules in effect now
+func (c *Cinema) ExplainSeat(ctx context.Context, request *cinema.ExplainSeatRequest) (*model.SeatExplanation, error) {
+	if request.Seat == nil {
+		return nil, fmt.Errorf("%w: missing seat", ErrInvalidArgument)
 	}
-	err = entity.ReserveSeats(seats, request.GroupName)
+	var explanation model.SeatExplanation
+	err := c.Read(ctx, request.Id, func(entity *model.Cinema) error {
+		seats, err := entity.FromPbSeats([]*cinema.Seat{request.Seat})
+		if err != nil {
+			return err
+		}
+		explanation = entity.ExplainSeat(seats[0][model.Row], seats[0][model.Col], request.GroupName)
+		return nil
+	})
 	if err != nil {
 		c.logger.Error(err)
+		return nil, err
+	}
+	return &explanation, nil
+}
+
+func (c *Cinema) ReserveSeats(ctx context.Context, request *cinema.ReserveSeatsRequest) (breakdown *model.PriceBreakdown, err error) {
+	defer func() {
+		c.audit(ctx, outcome(model.AuditEvent{
+			Operation: model.OpReserve,
+			CinemaID:  request.Id,
+			GroupName: request.GroupName,
+			Seats:     auditSeats(request.SeatCoords),
+		}, err))
+	}()
+	err = c.Apply(ctx, request.Id, func(entity *model.Cinema) error {
+		seats, err := entity.FromPbSeats(request.SeatCoords)
+		if err != nil {
+			return err
+		}
+		entity.ApplyDistancingPolicy(time.Now())
+		opts := model.ReserveOptions{Accessible: request.Accessible}
+		if request.Adjacency != nil {
+			policy := toAdjacencyPolicy(request.Adjacency)
+			opts.Adjacency = &policy
+		}
+		err = entity.ReserveSeats(seats, request.GroupName, opts)
+		if err != nil {
+			return err
+		}
+		breakdown, err = entity.PriceSeats(seats)
 		return err
+	})
+	if err != nil {
+		c.logger.Error(err)
+		return nil, err
 	}
-	err = c.repo.UpdateCinema(request.Id, entity)
+	return breakdown, nil
+}
+
+// CancelSeats cancels the seats then offers the freed space to the waitlist of the cinema
+func (c *Cinema) CancelSeats(ctx context.Context, request *cinema.CancelSeatsRequest) (err error) {
+	var held []model.AuditEvent
+	defer func() {
+		c.audit(ctx, append([]model.AuditEvent{outcome(model.AuditEvent{
+			Operation: model.OpCancel,
+			CinemaID:  request.Id,
+			Seats:     auditSeats(request.SeatCoords),
+		}, err)}, held...)...)
+	}()
+	err = c.Apply(ctx, request.Id, func(entity *model.Cinema) error {
+		seats, err := entity.FromPbSeats(request.SeatCoords)
+		if err != nil {
+			return err
+		}
+		if err := entity.CancelSeats(seats); err != nil {
+			return err
+		}
+		held, err = serveWaitlist(entity, request.Id)
+		return err
+	})
 	if err != nil {
+		held = nil
 		c.logger.Error(err)
 		return err
 	}
 	return nil
 }
 
-func (c *Cinema) CancelSeats(ctx context.Context, request *cinema.CancelSeatsRequest) error {
-	entity, err := c.repo.GetCinema(request.Id)
+// BatchReserve reserves every group of the request or none of them. When the batch is
+// rejected it returns model.ErrBatchRejected along with the error of each group.
+func (c *Cinema) BatchReserve(ctx context.Context, request *cinema.BatchReserveRequest) (breakdowns []*model.PriceBreakdown, errs []error, err error) {
+	defer func() {
+		events := make([]model.AuditEvent, 0, len(request.Groups))
+		for i, group := range request.Groups {
+			groupErr := err
+			if i < len(errs) && errs[i] != nil {
+				groupErr = errs[i]
+			}
+			events = append(events, outcome(model.AuditEvent{
+				Operation: model.OpBatchReserve,
+				CinemaID:  request.Id,
+				GroupName: group.GetGroupName(),
+				Seats:     auditSeats(group.GetSeatCoords()),
+			}, groupErr))
+		}
+		c.audit(ctx, events...)
+	}()
+	err = c.Apply(ctx, request.Id, func(entity *model.Cinema) error {
+		entity.ApplyDistancingPolicy(time.Now())
+		reservations := make([]model.GroupReservation, 0, len(request.Groups))
+		for _, group := range request.Groups {
+			if group == nil {
+				return errors.New("malformed group data")
+			}
+			seats, err := entity.FromPbSeats(group.SeatCoords)
+			if err != nil {
+				return err
+			}
+			reservations = append(reservations, model.GroupReservation{
+				GroupName:  group.GroupName,
+				SeatCoords: seats,
+				Options:    model.ReserveOptions{Accessible: group.Accessible},
+			})
+		}
+		var err error
+		errs, err = entity.BatchReserve(reservations)
+		if err != nil {
+			return err
+		}
+
+		breakdowns = make([]*model.PriceBreakdown, 0, len(reservations))
+		for _, r := range reservations {
+			breakdown, err := entity.PriceSeats(r.SeatCoords)
+			if err != nil {
+				return err
+			}
+			breakdowns = append(breakdowns, breakdown)
+		}
+		return nil
+	})
 	if err != nil {
 		c.logger.Error(err)
-		return err
+		return nil, errs, err
 	}
-	if entity == nil {
-		return fmt.Errorf("not found id %s", request.Id)
+	return breakdowns, nil, nil
+}
+
+// PlanSeating plans the seats of the parties and reserves them if requested,
+// it reports whether the plan has been applied
+func (c *Cinema) PlanSeating(ctx context.Context, request *cinema.PlanSeatingRequest) (plan *model.SeatingPlan, applied bool, err error) {
+	defer func() {
+		if !request.Apply {
+			return
+		}
+		if err != nil {
+			c.audit(ctx, outcome(model.AuditEvent{Operation: model.OpPlanApply, CinemaID: request.Id}, err))
+			return
+		}
+		if !applied {
+			return
+		}
+		events := make([]model.AuditEvent, 0, len(plan.Assignments))
+		for _, assignment := range plan.Assignments {
+			events = append(events, outcome(model.AuditEvent{
+				Operation: model.OpPlanApply,
+				CinemaID:  request.Id,
+				GroupName: assignment.GroupName,
+				Seats:     auditSeats(assignment.Seats),
+			}, nil))
+		}
+		c.audit(ctx, events...)
+	}()
+
+	parties := make([]model.Party, 0, len(request.Parties))
+	for i, party := range request.Parties {
+		if party == nil {
+			return nil, false, fmt.Errorf("%w: malformed party data", ErrInvalidArgument)
+		}
+		name := party.GroupName
+		if name == "" {
+			name = fmt.Sprintf("party-%d", i+1)
+		}
+		parties = append(parties, model.Party{
+			GroupName: name,
+			Size:      int(party.Size),
+			Priority:  int(party.Priority),
+		})
+	}
+	timeout := defaultPlanTimeout
+	if request.Timeout != nil {
+		timeout = min(request.Timeout.AsDuration(), maxPlanTimeout)
+	}
+	opts := model.PlanOptions{
+		Objective: model.PlanObjective(request.Objective),
+		Exact:     request.Exact,
+		Timeout:   timeout,
 	}
 
-	seats := make([][]int, 0)
-	for _, seat := range request.SeatCoords {
-		if seat == nil {
-			return errors.New("malformed seat data")
+	// the plan is applied under the same lock so that it cannot be outdated
+	apply := func(entity *model.Cinema) error {
+		if request.Apply {
+			entity.ApplyDistancingPolicy(time.Now())
+		}
+		var err error
+		plan, err = entity.PlanSeating(parties, opts)
+		if err != nil || !request.Apply || len(plan.Assignments) == 0 {
+			return err
 		}
-		seats = append(seats, []int{int(seat.Row), int(seat.Column)})
+		reservations := make([]model.GroupReservation, 0, len(plan.Assignments))
+		for _, assignment := range plan.Assignments {
+			reservations = append(reservations, model.GroupReservation{
+				GroupName:  assignment.GroupName,
+				SeatCoords: assignment.SeatCoords,
+			})
+		}
+		_, err = entity.BatchReserve(reservations)
+		applied = err == nil
+		return err
+	}
+	if request.Apply {
+		err = c.Apply(ctx, request.Id, apply)
+	} else {
+		err = c.Read(ctx, request.Id, apply)
 	}
-	err = entity.CancelSeats(seats)
 	if err != nil {
 		c.logger.Error(err)
-		return err
+		return nil, false, err
+	}
+	return plan, applied, nil
+}
+
+func (c *Cinema) AnalyzeCapacity(ctx context.Context, request *cinema.AnalyzeCapacityRequest) (*model.CapacityReport, error) {
+	minDistances := make([]int, 0, len(request.MinDistances))
+	for _, d := range request.MinDistances {
+		minDistances = append(minDistances, int(d))
+	}
+	groupSizes := make([]int, 0, len(request.GroupSizes))
+	for _, size := range request.GroupSizes {
+		groupSizes = append(groupSizes, int(size))
 	}
-	err = c.repo.UpdateCinema(request.Id, entity)
+	var report *model.CapacityReport
+	err := c.Read(ctx, request.Id, func(entity *model.Cinema) error {
+		var err error
+		report, err = entity.AnalyzeCapacity(minDistances, groupSizes)
+		return err
+	})
 	if err != nil {
 		c.logger.Error(err)
+		return nil, err
+	}
+	return report, nil
+}
+
+func (c *Cinema) SuggestSeats(ctx context.Context, request *cinema.SuggestSeatsRequest) ([]*cinema.Seat, error) {
+	if request.GroupSize <= 0 {
+		return nil, fmt.Errorf("%w: group size must be positive", ErrInvalidArgument)
+	}
+	var seats []*cinema.Seat
+	err := c.Read(ctx, request.Id, func(entity *model.Cinema) error {
+		var err error
+		seats, err = entity.ToPbSeats(entity.SuggestSeats(int(request.GroupSize), request.GroupName, request.Accessible, time.Now()))
 		return err
+	})
+	if err != nil {
+		c.logger.Error(err)
+		return nil, err
+	}
+	return seats, nil
+}
+
+func (c *Cinema) applyCategories(entity *model.Cinema, assignments []*cinema.CategoryAssignment) error {
+	for _, assignment := range assignments {
+		if assignment == nil {
+			return errors.New("malformed category data")
+		}
+		seats, err := entity.FromPbSeats(assignment.Seats)
+		if err != nil {
+			return err
+		}
+		for _, region := range assignment.Regions {
+			regionSeats, err := expandRegion(entity, region)
+			if err != nil {
+				return err
+			}
+			seats = append(seats, regionSeats...)
+		}
+		err = entity.SetSeatCategory(seats, model.SeatCategory(assignment.Category))
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
-func NewCinema(l *log.Logger, repo repository.ICinema) ICinema {
-	return &Cinema{
-		logger: l,
-		repo:   repo,
+func (c *Cinema) applyPrices(entity *model.Cinema, currency string, prices []*cinema.CategoryPrice) error {
+	table := make(map[model.SeatCategory]int64, len(prices))
+	for _, price := range prices {
+		if price == nil {
+			return errors.New("malformed price data")
+		}
+		table[model.SeatCategory(price.Category)] = price.Price
+	}
+	return entity.SetPrices(currency, table)
+}
+
+// expandRegion lists the seats of a rectangle from its top left corner to its bottom right one,
+// both in the cinema
+func expandRegion(entity *model.Cinema, region *cinema.SeatRegion) ([][]int, error) {
+	if region == nil || region.From == nil || region.To == nil {
+		return nil, fmt.Errorf("%w: malformed region data", ErrInvalidArgument)
+	}
+	corners, err := entity.FromPbSeats([]*cinema.Seat{region.From, region.To})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+	}
+	rows, columns := entity.Size()
+	for _, corner := range corners {
+		if corner[model.Row] < 0 || corner[model.Row] >= rows || corner[model.Col] < 0 || corner[model.Col] >= columns {
+			return nil, fmt.Errorf("%w: region corner (%d, %d) out of the %dx%d seats", ErrInvalidArgument, corner[model.Row], corner[model.Col], rows, columns)
+		}
+	}
+	fromRow, fromCol, toRow, toCol := corners[0][model.Row], corners[0][model.Col], corners[1][model.Row], corners[1][model.Col]
+	if fromRow > toRow || fromCol > toCol {
+		return nil, fmt.Errorf("%w: region must go from its top left corner to its bottom right one", ErrInvalidArgument)
+	}
+	seats := make([][]int, 0, (toRow-fromRow+1)*(toCol-fromCol+1))
+	for i := fromRow; i <= toRow; i++ {
+		for j := fromCol; j <= toCol; j++ {
+			seats = append(seats, []int{i, j})
+		}
+	}
+	return seats, nil
+}
+
+func toSeparationProfile(profile *cinema.SeparationProfile) model.SeparationProfile {
+	return model.SeparationProfile{
+		Horizontal: int(profile.Horizontal),
+		Vertical:   int(profile.Vertical),
+		Diagonal:   int(profile.Diagonal),
+		Mask:       profile.Mask,
+	}
+}
+
+func toAdjacencyPolicy(policy *cinema.AdjacencyPolicy) model.AdjacencyPolicy {
+	return model.AdjacencyPolicy{
+		Rule:       model.Adjacency(policy.Rule),
+		AllowSplit: policy.AllowSplit,
+	}
+}
+
+func toLabelScheme(scheme *cinema.LabelScheme) model.LabelScheme {
+	return model.LabelScheme{
+		RowStyle:    model.RowLabelStyle(scheme.RowStyle),
+		SkipLetters: scheme.SkipLetters,
+		Numbering:   model.SeatNumbering(scheme.Numbering),
+	}
+}
+
+func NewCinema(l *log.Logger, repo repository.ICinema, venues repository.IVenue, audits repository.IAudit, webhooks *webhook.Dispatcher) ICinema {
+	c := &Cinema{
+		logger:   l,
+		repo:     repo,
+		venues:   venues,
+		audits:   audits,
+		webhooks: webhooks,
 	}
+	repo.Subscribe(c.publishWebhooks)
+	return c
 }
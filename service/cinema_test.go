@@ -0,0 +1,72 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/t3201v/seat-arrangement/gen/cinema"
+	"github.com/t3201v/seat-arrangement/repository"
+)
+
+func TestCinema_ConfigureSize(t *testing.T) {
+	svc, ctx := newTestCinema(t)
+	for _, request := range []*cinema.ConfigureCinemaRequest{
+		{Rows: -1, Columns: 5},
+		{Rows: 5, Columns: 0},
+		{Rows: 100000, Columns: 100000},
+		{Rows: 5, Columns: 5, MinDistance: -1},
+	} {
+		if _, err := svc.ConfigureCinema(ctx, request); !errors.Is(err, ErrInvalidArgument) {
+			t.Errorf("ConfigureCinema(%dx%d, %d) error = %v, want an invalid argument", request.Rows, request.Columns, request.MinDistance, err)
+		}
+	}
+
+	id, err := svc.ConfigureCinema(ctx, &cinema.ConfigureCinemaRequest{Rows: 2, Columns: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = svc.UpdateCinemaConfig(ctx, &cinema.UpdateCinemaConfigRequest{Id: id, Rows: -1, Columns: 5})
+	if !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("UpdateCinemaConfig(-1x5) error = %v, want an invalid argument", err)
+	}
+	m, err := svc.GetSeatMap(ctx, &cinema.GetSeatMapRequest{Id: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Rows != 2 || m.Columns != 3 {
+		t.Errorf("seat map is %dx%d after a rejected update, want 2x3", m.Rows, m.Columns)
+	}
+}
+
+func TestCinema_ErrorKinds(t *testing.T) {
+	svc, ctx := newTestCinema(t)
+	id, err := svc.ConfigureCinema(ctx, &cinema.ConfigureCinemaRequest{Rows: 2, Columns: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = svc.ReserveSeats(ctx, &cinema.ReserveSeatsRequest{Id: id, GroupName: "smith", SeatCoords: []*cinema.Seat{{Row: 5, Column: 0}}})
+	if !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("ReserveSeats(out of the cinema) error = %v, want an invalid argument", err)
+	}
+	err = svc.CancelSeats(ctx, &cinema.CancelSeatsRequest{Id: id, SeatCoords: []*cinema.Seat{{Row: 0, Column: 0}}})
+	if !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("CancelSeats(free seat) error = %v, want an invalid argument", err)
+	}
+	_, err = svc.ImportCinema(ctx, &cinema.ImportCinemaRequest{Data: "{"})
+	if !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("ImportCinema(malformed) error = %v, want an invalid argument", err)
+	}
+	_, err = svc.GetSeatMap(ctx, &cinema.GetSeatMapRequest{Id: "not an id"})
+	if !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("GetSeatMap(malformed id) error = %v, want an invalid argument", err)
+	}
+
+	_, err = svc.GetSeatMap(ctx, &cinema.GetSeatMapRequest{Id: repository.NewID()})
+	if !errors.Is(err, repository.ErrNotFound) || errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("GetSeatMap(unknown id) error = %v, want not found", err)
+	}
+	err = svc.CancelSeats(ctx, &cinema.CancelSeatsRequest{Id: repository.NewID(), SeatCoords: []*cinema.Seat{{Row: 0, Column: 0}}})
+	if !errors.Is(err, repository.ErrNotFound) || errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("CancelSeats(unknown id) error = %v, want not found", err)
+	}
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/t3201v/seat-arrangement/gen/cinema"
+	"github.com/t3201v/seat-arrangement/internal/model"
+	"github.com/t3201v/seat-arrangement/repository"
+)
+
+// cinemaAt returns the version of the cinema at the point in time, the current one if nil
+func (c *Cinema) cinemaAt(ctx context.Context, id string, point *cinema.PointInTime) (*model.Cinema, repository.Version, error) {
+	key, err := c.key(ctx, id)
+	if err != nil {
+		return nil, repository.Version{}, err
+	}
+	switch p := point.GetPoint().(type) {
+	case *cinema.PointInTime_Version:
+		return c.repo.GetCinemaVersion(key, int(p.Version))
+	case *cinema.PointInTime_Time:
+		return c.repo.GetCinemaAt(key, p.Time.AsTime())
+	}
+	versions, err := c.repo.ListVersions(key)
+	if err != nil {
+		return nil, repository.Version{}, err
+	}
+	return c.repo.GetCinemaVersion(key, versions[len(versions)-1].Number)
+}
+
+func (c *Cinema) ListCinemaVersions(ctx context.Context, request *cinema.ListCinemaVersionsRequest) ([]repository.Version, error) {
+	key, err := c.key(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return c.repo.ListVersions(key)
+}
+
+// DiffCinema compares two versions, it returns their numbers and the changed seats
+func (c *Cinema) DiffCinema(ctx context.Context, request *cinema.DiffCinemaRequest) (int, int, []model.SeatChange, error) {
+	if request.From == nil {
+		return 0, 0, nil, fmt.Errorf("%w: missing version to compare from", ErrInvalidArgument)
+	}
+	from, fromVersion, err := c.cinemaAt(ctx, request.Id, request.From)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	to, toVersion, err := c.cinemaAt(ctx, request.Id, request.To)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	changes, err := from.Diff(to)
+	if err != nil {
+		return 0, 0, nil, invalid(err)
+	}
+	return fromVersion.Number, toVersion.Number, changes, nil
+}
+
+// RevertCinema restores the reservations of a previous version, it returns the new version
+// and the changed seats
+func (c *Cinema) RevertCinema(ctx context.Context, request *cinema.RevertCinemaRequest) (number int, changes []model.SeatChange, err error) {
+	defer func() {
+		event := model.AuditEvent{Operation: model.OpRevert, CinemaID: request.Id}
+		for _, change := range changes {
+			event.Seats = append(event.Seats, model.AuditSeat{Row: change.Row, Column: change.Col, Label: change.Label})
+		}
+		c.audit(ctx, outcome(event, err))
+	}()
+	if request.To == nil {
+		return 0, nil, fmt.Errorf("%w: missing version to revert to", ErrInvalidArgument)
+	}
+	old, _, err := c.cinemaAt(ctx, request.Id, request.To)
+	if err != nil {
+		return 0, nil, err
+	}
+	// the version is taken under the lock of the update, a concurrent one cannot be reported
+	version, err := c.applyVersion(ctx, request.Id, func(entity *model.Cinema) error {
+		var err error
+		changes, err = entity.RevertTo(old, request.DiscardNewer)
+		return err
+	})
+	if err != nil {
+		c.logger.Error(err)
+		return 0, nil, err
+	}
+	return version.Number, changes, nil
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/t3201v/seat-arrangement/gen/cinema"
+	"github.com/t3201v/seat-arrangement/internal/model"
+)
+
+func (c *Cinema) ListDistancingPolicies(ctx context.Context, request *cinema.ListDistancingPoliciesRequest) ([]model.DistancingPolicy, *model.DistancingPolicy, error) {
+	var policies []model.DistancingPolicy
+	var current *model.DistancingPolicy
+	err := c.Read(ctx, request.Id, func(entity *model.Cinema) error {
+		policies = entity.DistancingPolicies()
+		if policy, ok := entity.PolicyAt(time.Now()); ok {
+			current = &policy
+		}
+		return nil
+	})
+	if err != nil {
+		c.logger.Error(err)
+		return nil, nil, err
+	}
+	return policies, current, nil
+}
+
+// GetPolicyViolations returns the policy in effect at the requested time, or the next one,
+// along with the reservations which break it
+func (c *Cinema) GetPolicyViolations(ctx context.Context, request *cinema.GetPolicyViolationsRequest) (model.DistancingPolicy, []model.PolicyViolation, error) {
+	var policy model.DistancingPolicy
+	var violations []model.PolicyViolation
+	err := c.Read(ctx, request.Id, func(entity *model.Cinema) error {
+		var ok bool
+		if request.At != nil {
+			policy, ok = entity.PolicyAt(request.At.AsTime())
+			if !ok {
+				return fmt.Errorf("no distancing policy in effect at %s", request.At.AsTime().Format(time.RFC3339))
+			}
+		} else {
+			policy, ok = entity.NextPolicy(time.Now())
+			if !ok {
+				return errors.New("no upcoming distancing policy")
+			}
+		}
+		violations = entity.PolicyViolations(policy)
+		return nil
+	})
+	if err != nil {
+		c.logger.Error(err)
+		return model.DistancingPolicy{}, nil, err
+	}
+	return policy, violations, nil
+}
+
+func toDistancingPolicies(policies []*cinema.DistancingPolicy) ([]model.DistancingPolicy, error) {
+	result := make([]model.DistancingPolicy, 0, len(policies))
+	for _, policy := range policies {
+		if policy == nil || policy.EffectiveFrom == nil {
+			return nil, fmt.Errorf("%w: distancing policy must have an effective time", ErrInvalidArgument)
+		}
+		p := model.DistancingPolicy{
+			EffectiveFrom: policy.EffectiveFrom.AsTime(),
+			MinDistance:   int(policy.MinDistance),
+		}
+		if policy.Separation != nil {
+			p.Separation = toSeparationProfile(policy.Separation)
+		}
+		result = append(result, p)
+	}
+	return result, nil
+}
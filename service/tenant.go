@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/t3201v/seat-arrangement/gen/cinema"
+	"github.com/t3201v/seat-arrangement/internal/auth"
+	"github.com/t3201v/seat-arrangement/internal/model"
+	"github.com/t3201v/seat-arrangement/repository"
+)
+
+// tenant is the organization of the caller, bound to its api key by the controller. Calls
+// without one see no cinema.
+func tenant(ctx context.Context) string {
+	p, _ := auth.FromContext(ctx)
+	return p.Tenant
+}
+
+// owner is tenant for the calls creating something, it fails for calls without one
+func owner(ctx context.Context) (string, error) {
+	org := tenant(ctx)
+	if org == "" {
+		return "", errors.New("unauthenticated caller")
+	}
+	return org, nil
+}
+
+// key checks the format of a cinema id and scopes it to the organization of the caller, the
+// numeric ids of the previous versions are resolved while the repository issues them
+func (c *Cinema) key(ctx context.Context, id string) (repository.Key, error) {
+	org := tenant(ctx)
+	if repository.ValidateID(id) == nil {
+		return repository.Key{Tenant: org, ID: id}, nil
+	}
+	if _, err := strconv.ParseUint(id, 10, 63); err != nil {
+		return repository.Key{}, fmt.Errorf("%w: invalid id", ErrInvalidArgument)
+	}
+	resolved, err := c.repo.Resolve(org, id)
+	if err != nil {
+		return repository.Key{}, err
+	}
+	c.logger.Warnf("numeric cinema id %s is deprecated, use %s", id, resolved)
+	return repository.Key{Tenant: org, ID: resolved}, nil
+}
+
+// unsettled reports whether the cinema has changes due by time not stored yet
+func unsettled(entity *model.Cinema, now time.Time) bool {
+	return entity.PolicyDue(now) || entity.HoldExpired(now)
+}
+
+// settle stores the changes due by time: the distancing policy coming into effect and the
+// release of the expired holds, whose seats are offered to the waitlist
+func (c *Cinema) settle(ctx context.Context, key repository.Key) error {
+	var held []model.AuditEvent
+	err := c.repo.Update(key, func(entity *model.Cinema) error {
+		now := time.Now()
+		if !unsettled(entity, now) {
+			return nil
+		}
+		entity.ApplyDistancingPolicy(now)
+		if !entity.HoldExpired(now) {
+			return nil
+		}
+		var err error
+		held, err = serveWaitlist(entity, key.ID)
+		return err
+	})
+	c.audit(ctx, held...)
+	return err
+}
+
+// Read calls fn with the cinema of the caller under its read lock, other cinemas are not held
+// up. The changes due by time are stored first rather than applied to a copy of the cinema
+// on every read. fn must neither change nor keep the cinema, its errors are invalid arguments.
+func (c *Cinema) Read(ctx context.Context, id string, fn func(entity *model.Cinema) error) error {
+	key, err := c.key(ctx, id)
+	if err != nil {
+		return err
+	}
+	due := false
+	err = c.repo.View(key, func(entity *model.Cinema) error {
+		if due = unsettled(entity, time.Now()); due {
+			return nil
+		}
+		return invalid(fn(entity))
+	})
+	if err != nil || !due {
+		return err
+	}
+	if err := c.settle(ctx, key); err != nil {
+		return err
+	}
+	return c.repo.View(key, func(entity *model.Cinema) error {
+		return invalid(fn(entity))
+	})
+}
+
+// Apply calls fn with the cinema of the caller under its write lock and stores the events it
+// emits, fn changes the cinema in place and the cinema is rebuilt only if fn fails after
+// changing it. The changes due by time are stored first, so that expired holds do not block
+// their seats even when fn fails. The errors of fn are invalid arguments.
+func (c *Cinema) Apply(ctx context.Context, id string, fn func(entity *model.Cinema) error) error {
+	_, err := c.applyVersion(ctx, id, fn)
+	return err
+}
+
+// applyVersion is Apply returning the version of the cinema it leads to
+func (c *Cinema) applyVersion(ctx context.Context, id string, fn func(entity *model.Cinema) error) (repository.Version, error) {
+	key, err := c.key(ctx, id)
+	if err != nil {
+		return repository.Version{}, err
+	}
+	if err := c.settle(ctx, key); err != nil {
+		return repository.Version{}, err
+	}
+	return c.repo.UpdateVersion(key, func(entity *model.Cinema) error {
+		return invalid(fn(entity))
+	})
+}
+
+// insertCinema stores a new cinema of the organization of the caller, as a hall of the venue if any
+func (c *Cinema) insertCinema(ctx context.Context, venueID string, entity *model.Cinema) (string, error) {
+	org, err := owner(ctx)
+	if err != nil {
+		return "", err
+	}
+	if venueID == "" {
+		return c.repo.InsertCinema(org, entity)
+	}
+	if err := repository.ValidateID(venueID); err != nil {
+		return "", fmt.Errorf("%w: venue: %w", ErrInvalidArgument, err)
+	}
+	var id string
+	err = c.venues.UpdateVenue(org, venueID, func(venue *model.Venue) error {
+		var err error
+		id, err = c.repo.InsertCinema(org, entity)
+		venue.Halls = append(venue.Halls, id)
+		return err
+	})
+	return id, err
+}
+
+func (c *Cinema) CreateVenue(ctx context.Context, request *cinema.CreateVenueRequest) (*model.Venue, error) {
+	name := strings.TrimSpace(request.Name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: venue name must not be empty", ErrInvalidArgument)
+	}
+	org, err := owner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	venue := model.Venue{Name: name, Address: request.Address, CreatedAt: time.Now()}
+	id, err := c.venues.InsertVenue(org, venue)
+	if err != nil {
+		c.logger.Error(err)
+		return nil, err
+	}
+	venue.ID = id
+	return &venue, nil
+}
+
+func (c *Cinema) ListVenues(ctx context.Context, request *cinema.ListVenuesRequest) ([]model.Venue, error) {
+	return c.venues.ListVenues(tenant(ctx)), nil
+}
+
+func (c *Cinema) DeleteVenue(ctx context.Context, request *cinema.DeleteVenueRequest) error {
+	if err := repository.ValidateID(request.Id); err != nil {
+		return invalid(err)
+	}
+	err := c.venues.UpdateVenue(tenant(ctx), request.Id, func(venue *model.Venue) error {
+		if len(venue.Halls) > 0 {
+			return fmt.Errorf("%w: venue %s still has %d halls", ErrInvalidArgument, venue.ID, len(venue.Halls))
+		}
+		return nil
+	})
+	if err == nil {
+		err = c.venues.DeleteVenue(tenant(ctx), request.Id)
+	}
+	if err != nil {
+		c.logger.Error(err)
+		return err
+	}
+	return nil
+}
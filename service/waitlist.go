@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/t3201v/seat-arrangement/gen/cinema"
+	"github.com/t3201v/seat-arrangement/internal/model"
+)
+
+// serveWaitlist offers the seats freed by a change to the waitlisted parties and returns
+// the audit events of the seats held
+func serveWaitlist(entity *model.Cinema, cinemaID string) ([]model.AuditEvent, error) {
+	now := time.Now()
+	entity.ApplyDistancingPolicy(now)
+	events := make([]model.AuditEvent, 0)
+	for _, offer := range entity.ServeWaitlist(now) {
+		if !offer.Held {
+			continue
+		}
+		seats, err := entity.ToPbSeats(offer.SeatCoords)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, model.AuditEvent{
+			Operation: model.OpHold,
+			CinemaID:  cinemaID,
+			GroupName: offer.GroupName,
+			Seats:     auditSeats(seats),
+			Success:   true,
+		})
+	}
+	return events, nil
+}
+
+func (c *Cinema) JoinWaitlist(ctx context.Context, request *cinema.JoinWaitlistRequest) (position int, waiting int, err error) {
+	defer func() {
+		c.audit(ctx, outcome(model.AuditEvent{
+			Operation: model.OpWaitlistJoin,
+			CinemaID:  request.Id,
+			GroupName: request.GroupName,
+		}, err))
+	}()
+	err = c.Apply(ctx, request.Id, func(entity *model.Cinema) error {
+		var err error
+		position, err = entity.JoinWaitlist(model.WaitlistEntry{
+			GroupName:  request.GroupName,
+			Size:       int(request.Size),
+			Accessible: request.Accessible,
+			AutoHold:   request.AutoHold,
+			HoldFor:    request.HoldFor.AsDuration(),
+		})
+		waiting = len(entity.Waitlist())
+		return err
+	})
+	if err != nil {
+		c.logger.Error(err)
+		return 0, 0, err
+	}
+	return position, waiting, nil
+}
+
+func (c *Cinema) LeaveWaitlist(ctx context.Context, request *cinema.WaitlistGroupRequest) (err error) {
+	defer func() {
+		c.audit(ctx, outcome(model.AuditEvent{
+			Operation: model.OpWaitlistLeave,
+			CinemaID:  request.Id,
+			GroupName: request.GroupName,
+		}, err))
+	}()
+	err = c.Apply(ctx, request.Id, func(entity *model.Cinema) error {
+		return entity.LeaveWaitlist(request.GroupName)
+	})
+	if err != nil {
+		c.logger.Error(err)
+		return err
+	}
+	return nil
+}
+
+// GetWaitlistPosition returns the position of the party on the waitlist, or 0 and its
+// hold when seats are held for it
+func (c *Cinema) GetWaitlistPosition(ctx context.Context, request *cinema.WaitlistGroupRequest) (int, int, *model.Hold, error) {
+	var position, waiting int
+	var hold *model.Hold
+	err := c.Read(ctx, request.Id, func(entity *model.Cinema) error {
+		waiting = len(entity.Waitlist())
+		if h, ok := entity.HoldOf(request.GroupName); ok && time.Now().Before(h.Until) {
+			hold = &h
+			return nil
+		}
+		var err error
+		position, err = entity.WaitlistPosition(request.GroupName)
+		return err
+	})
+	if err != nil {
+		c.logger.Error(err)
+		return 0, 0, nil, err
+	}
+	return position, waiting, hold, nil
+}
+
+func (c *Cinema) GetWaitlist(ctx context.Context, request *cinema.GetWaitlistRequest) ([]model.WaitlistEntry, []model.Hold, error) {
+	var entries []model.WaitlistEntry
+	var holds []model.Hold
+	err := c.Read(ctx, request.Id, func(entity *model.Cinema) error {
+		entries = entity.Waitlist()
+		holds = entity.Holds()
+		return nil
+	})
+	if err != nil {
+		c.logger.Error(err)
+		return nil, nil, err
+	}
+	return entries, holds, nil
+}
+
+func (c *Cinema) ConfirmHold(ctx context.Context, request *cinema.WaitlistGroupRequest) (err error) {
+	defer func() {
+		c.audit(ctx, outcome(model.AuditEvent{
+			Operation: model.OpHoldConfirm,
+			CinemaID:  request.Id,
+			GroupName: request.GroupName,
+		}, err))
+	}()
+	err = c.Apply(ctx, request.Id, func(entity *model.Cinema) error {
+		return entity.ConfirmHold(request.GroupName, time.Now())
+	})
+	if err != nil {
+		c.logger.Error(err)
+		return err
+	}
+	return nil
+}
+
+// ReleaseHold frees the seats held for the party, which are then offered to the waitlist
+func (c *Cinema) ReleaseHold(ctx context.Context, request *cinema.WaitlistGroupRequest) (err error) {
+	var held []model.AuditEvent
+	defer func() {
+		c.audit(ctx, append([]model.AuditEvent{outcome(model.AuditEvent{
+			Operation: model.OpHoldRelease,
+			CinemaID:  request.Id,
+			GroupName: request.GroupName,
+		}, err)}, held...)...)
+	}()
+	err = c.Apply(ctx, request.Id, func(entity *model.Cinema) error {
+		if err := entity.ReleaseHold(request.GroupName); err != nil {
+			return err
+		}
+		var err error
+		held, err = serveWaitlist(entity, request.Id)
+		return err
+	})
+	if err != nil {
+		held = nil
+		c.logger.Error(err)
+		return err
+	}
+	return nil
+}
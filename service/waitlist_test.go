@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/t3201v/seat-arrangement/gen/cinema"
+	"github.com/t3201v/seat-arrangement/internal/auth"
+	"github.com/t3201v/seat-arrangement/internal/webhook"
+	"github.com/t3201v/seat-arrangement/repository"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func newTestCinema(t *testing.T) (ICinema, context.Context) {
+	t.Helper()
+	l := log.StandardLogger()
+	audits, err := repository.NewAudit(l, filepath.Join(t.TempDir(), "audit.log"), 1<<20, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := NewCinema(l, repository.NewCinema(l, false), repository.NewVenue(l), audits, webhook.NewDispatcher(l, webhook.Options{}))
+	return svc, auth.NewContext(context.Background(), auth.Principal{Tenant: "acme"})
+}
+
+func TestCinema_ExpiredHoldFreesSeats(t *testing.T) {
+	svc, ctx := newTestCinema(t)
+	id, err := svc.ConfigureCinema(ctx, &cinema.ConfigureCinemaRequest{Rows: 1, Columns: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	seats := []*cinema.Seat{{Row: 0, Column: 0}, {Row: 0, Column: 1}}
+	if _, err := svc.ReserveSeats(ctx, &cinema.ReserveSeatsRequest{Id: id, GroupName: "a", SeatCoords: seats}); err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = svc.JoinWaitlist(ctx, &cinema.JoinWaitlistRequest{Id: id, GroupName: "b", Size: 2, AutoHold: true, HoldFor: durationpb.New(20 * time.Millisecond)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.CancelSeats(ctx, &cinema.CancelSeatsRequest{Id: id, SeatCoords: seats}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.ReserveSeats(ctx, &cinema.ReserveSeatsRequest{Id: id, GroupName: "c", SeatCoords: seats}); err == nil {
+		t.Fatalf("ReserveSeats() of seats held for b succeeded")
+	}
+
+	// nobody cancels, the hold ends by itself
+	time.Sleep(30 * time.Millisecond)
+	available, _, err := svc.GetAvailableSeats(ctx, &cinema.GetAvailableSeatsRequest{Id: id})
+	if err != nil || len(available) != 2 {
+		t.Errorf("GetAvailableSeats() after the hold expired = %d seats, %v, want 2", len(available), err)
+	}
+	if _, err := svc.ReserveSeats(ctx, &cinema.ReserveSeatsRequest{Id: id, GroupName: "c", SeatCoords: seats}); err != nil {
+		t.Errorf("ReserveSeats() after the hold expired = %v", err)
+	}
+	if _, holds, _ := svc.GetWaitlist(ctx, &cinema.GetWaitlistRequest{Id: id}); len(holds) != 0 {
+		t.Errorf("holds left after they expired: %+v", holds)
+	}
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/t3201v/seat-arrangement/gen/cinema"
+	"github.com/t3201v/seat-arrangement/internal/model"
+	"github.com/t3201v/seat-arrangement/internal/webhook"
+	"github.com/t3201v/seat-arrangement/repository"
+)
+
+type webhookSeat struct {
+	Row    int    `json:"row"`
+	Column int    `json:"column"`
+	Label  string `json:"label"`
+}
+
+type webhookReservation struct {
+	GroupName string        `json:"group_name"`
+	Seats     []webhookSeat `json:"seats"`
+}
+
+type webhookCancellation struct {
+	Seats []webhookSeat `json:"seats"`
+}
+
+type webhookHold struct {
+	GroupName string        `json:"group_name"`
+	Seats     []webhookSeat `json:"seats,omitempty"`
+	Until     *time.Time    `json:"until,omitempty"`
+}
+
+type webhookConfig struct {
+	Rows        int    `json:"rows"`
+	Columns     int    `json:"columns"`
+	MinDistance int    `json:"min_distance"`
+	Currency    string `json:"currency,omitempty"`
+}
+
+func toWebhookConfig(config model.Config) webhookConfig {
+	return webhookConfig{
+		Rows:        config.Rows,
+		Columns:     config.Columns,
+		MinDistance: config.MinDistance,
+		Currency:    config.Currency,
+	}
+}
+
+func toWebhookSeats(entity *model.Cinema, seatCoords [][]int) []webhookSeat {
+	result := make([]webhookSeat, 0, len(seatCoords))
+	for _, seat := range seatCoords {
+		result = append(result, webhookSeat{
+			Row:    seat[model.Row],
+			Column: seat[model.Col],
+			Label:  entity.SeatLabel(seat[model.Row], seat[model.Col]),
+		})
+	}
+	return result
+}
+
+// publishWebhooks is the repository subscriber sending the stored events to the webhooks
+func (c *Cinema) publishWebhooks(key repository.Key, entity *model.Cinema, events []repository.RecordedEvent) {
+	for _, e := range events {
+		var data any
+		switch event := e.Event.(type) {
+		case model.CinemaConfigured:
+			data = toWebhookConfig(event.Config)
+		case model.ConfigUpdated:
+			data = toWebhookConfig(event.Config)
+		case model.SeatsReserved:
+			data = webhookReservation{GroupName: event.GroupName, Seats: toWebhookSeats(entity, event.SeatCoords)}
+		case model.SeatsCancelled:
+			data = webhookCancellation{Seats: toWebhookSeats(entity, event.SeatCoords)}
+		case model.SeatsHeld:
+			data = webhookHold{GroupName: event.GroupName, Seats: toWebhookSeats(entity, event.SeatCoords), Until: &event.Until}
+		case model.HoldConfirmed:
+			data = webhookHold{GroupName: event.GroupName}
+		case model.HoldReleased:
+			data = webhookHold{GroupName: event.GroupName, Seats: toWebhookSeats(entity, event.SeatCoords)}
+		case model.WaitlistNotified:
+			data = webhookReservation{GroupName: event.GroupName, Seats: toWebhookSeats(entity, event.SeatCoords)}
+		default:
+			continue
+		}
+		c.webhooks.Publish(webhook.Event{
+			Type:     e.Version.Event,
+			Tenant:   key.Tenant,
+			CinemaID: key.ID,
+			Version:  e.Number,
+			Time:     e.Time,
+			Data:     data,
+		})
+	}
+}
+
+func (c *Cinema) CreateWebhook(ctx context.Context, request *cinema.CreateWebhookRequest) (*webhook.Subscription, error) {
+	org, err := owner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if request.CinemaId != "" {
+		key, err := c.key(ctx, request.CinemaId)
+		if err != nil {
+			return nil, err
+		}
+		request.CinemaId = key.ID
+	}
+	subscription, err := c.webhooks.Subscribe(webhook.Subscription{
+		Tenant:     org,
+		URL:        request.Url,
+		EventTypes: request.EventTypes,
+		Secret:     request.Secret,
+		CinemaID:   request.CinemaId,
+	})
+	if err != nil {
+		c.logger.Error(err)
+		return nil, invalid(err)
+	}
+	return subscription, nil
+}
+
+func (c *Cinema) ListWebhooks(ctx context.Context, request *cinema.ListWebhooksRequest) ([]webhook.Subscription, error) {
+	return c.webhooks.Subscriptions(tenant(ctx)), nil
+}
+
+func (c *Cinema) DeleteWebhook(ctx context.Context, request *cinema.DeleteWebhookRequest) error {
+	err := c.webhooks.Unsubscribe(tenant(ctx), request.Id)
+	if err != nil {
+		c.logger.Error(err)
+		return invalid(err)
+	}
+	return nil
+}
+
+func (c *Cinema) ListWebhookDeliveries(ctx context.Context, request *cinema.ListWebhookDeliveriesRequest) ([]webhook.Delivery, error) {
+	var status *webhook.Status
+	if request.Status != cinema.DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED {
+		if _, ok := cinema.DeliveryStatus_name[int32(request.Status)]; !ok {
+			return nil, fmt.Errorf("%w: unknown delivery status %d", ErrInvalidArgument, request.Status)
+		}
+		s := webhook.Status(request.Status - 1)
+		status = &s
+	}
+	return c.webhooks.Deliveries(tenant(ctx), request.WebhookId, status), nil
+}
+
+func (c *Cinema) RetryWebhookDelivery(ctx context.Context, request *cinema.RetryWebhookDeliveryRequest) error {
+	err := c.webhooks.Retry(tenant(ctx), request.Id)
+	if err != nil {
+		c.logger.Error(err)
+		return invalid(err)
+	}
+	return nil
+}